@@ -0,0 +1,364 @@
+package hyperion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	m "github.com/denwwer/hyperion-ng/internal/model"
+	"github.com/denwwer/hyperion-ng/model"
+)
+
+const cmdAuthorize = "authorize"
+
+// List of authorize subcommands.
+const (
+	authTokenRequired = "tokenRequired"
+	authAdminRequired = "adminRequired"
+	authLogin         = "login"
+	authLogout        = "logout"
+	authNewPassword   = "newPassword"
+	authCreateToken   = "createToken"
+	authRenameToken   = "renameToken"
+	authDeleteToken   = "deleteToken"
+	authTokenList     = "getTokenList"
+	authRequestToken  = "requestToken"
+)
+
+// Token request is kept by Hyperion until it is approved, denied or expired.
+const (
+	tokenRequestExpiry = 180 * time.Second
+	tokenRequestGrace  = 5 * time.Second // Extra time to receive response about expiration
+	tokenCancelTimeout = time.Second
+)
+
+// Validation errors of authorization.
+var (
+	ErrPasswordRequired error = validationError("password is required")
+	ErrTokenIDRequired  error = validationError("token id is required")
+	ErrTokenIDLength    error = validationError("token id should have 5 characters")
+	ErrCommentLength    error = validationError("comment should have at least 10 characters")
+)
+
+// Token request errors.
+var (
+	ErrTokenDenied            = errors.New("token request is denied") // Admin rejected token request
+	ErrTokenRequestNotAllowed = errors.New("token request requires TCP or WebSocket connection")
+)
+
+// credentials shared by client copies and transports, so login affects all of them.
+type credentials struct {
+	mu    sync.RWMutex
+	token string
+}
+
+func (c *credentials) get() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.token
+}
+
+func (c *credentials) set(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.token = token
+}
+
+// TokenRequired check if authorization is required for API access.
+func (c *Client) TokenRequired() (bool, error) {
+	return c.TokenRequiredContext(context.Background())
+}
+
+// TokenRequiredContext same as TokenRequired with context.
+func (c *Client) TokenRequiredContext(ctx context.Context) (bool, error) {
+	resp := struct {
+		Required bool `json:"required"`
+	}{}
+
+	return resp.Required, c.send(ctx, m.Request{Command: cmdAuthorize, Subcommand: authTokenRequired}, &resp)
+}
+
+// AdminRequired check if admin authorization is required for restricted commands.
+func (c *Client) AdminRequired() (bool, error) {
+	return c.AdminRequiredContext(context.Background())
+}
+
+// AdminRequiredContext same as AdminRequired with context.
+func (c *Client) AdminRequiredContext(ctx context.Context) (bool, error) {
+	resp := struct {
+		Required bool `json:"adminRequired"`
+	}{}
+
+	return resp.Required, c.send(ctx, m.Request{Command: cmdAuthorize, Subcommand: authAdminRequired}, &resp)
+}
+
+// Login with admin password, received session token is used for next requests.
+func (c *Client) Login(password string) error {
+	return c.LoginContext(context.Background(), password)
+}
+
+// LoginContext same as Login with context.
+func (c *Client) LoginContext(ctx context.Context, password string) error {
+	if password == "" {
+		return ErrPasswordRequired
+	}
+
+	req := struct {
+		m.Request
+		Password string `json:"password"`
+	}{
+		Request:  m.Request{Command: cmdAuthorize, Subcommand: authLogin},
+		Password: password,
+	}
+
+	resp := struct {
+		Token string `json:"token"`
+	}{}
+
+	if err := c.send(ctx, req, &resp); err != nil {
+		return err
+	}
+
+	if resp.Token != "" {
+		c.token.set(resp.Token)
+	}
+
+	return nil
+}
+
+// Logout from current session, token is forgotten by client.
+func (c *Client) Logout() error {
+	return c.LogoutContext(context.Background())
+}
+
+// LogoutContext same as Logout with context.
+func (c *Client) LogoutContext(ctx context.Context) error {
+	if err := c.send(ctx, m.Request{Command: cmdAuthorize, Subcommand: authLogout}, nil); err != nil {
+		return err
+	}
+
+	c.token.set("")
+	return nil
+}
+
+// NewPassword change admin password.
+func (c *Client) NewPassword(password, newPassword string) error {
+	return c.NewPasswordContext(context.Background(), password, newPassword)
+}
+
+// NewPasswordContext same as NewPassword with context.
+func (c *Client) NewPasswordContext(ctx context.Context, password, newPassword string) error {
+	if password == "" || newPassword == "" {
+		return ErrPasswordRequired
+	}
+
+	req := struct {
+		m.Request
+		Password    string `json:"password"`
+		NewPassword string `json:"newPassword"`
+	}{
+		Request:     m.Request{Command: cmdAuthorize, Subcommand: authNewPassword},
+		Password:    password,
+		NewPassword: newPassword,
+	}
+
+	return c.send(ctx, req, nil)
+}
+
+// CreateToken for API access, comment describes token owner.
+func (c *Client) CreateToken(comment string) (*model.Token, error) {
+	return c.CreateTokenContext(context.Background(), comment)
+}
+
+// CreateTokenContext same as CreateToken with context.
+func (c *Client) CreateTokenContext(ctx context.Context, comment string) (*model.Token, error) {
+	req := struct {
+		m.Request
+		Comment string `json:"comment"`
+	}{
+		Request: m.Request{Command: cmdAuthorize, Subcommand: authCreateToken},
+		Comment: comment,
+	}
+
+	resp := &model.Token{}
+	return resp, c.send(ctx, req, resp)
+}
+
+// RenameToken change token comment.
+func (c *Client) RenameToken(id, comment string) error {
+	return c.RenameTokenContext(context.Background(), id, comment)
+}
+
+// RenameTokenContext same as RenameToken with context.
+func (c *Client) RenameTokenContext(ctx context.Context, id, comment string) error {
+	if id == "" {
+		return ErrTokenIDRequired
+	}
+
+	req := struct {
+		m.Request
+		ID      string `json:"id"`
+		Comment string `json:"comment"`
+	}{
+		Request: m.Request{Command: cmdAuthorize, Subcommand: authRenameToken},
+		ID:      id,
+		Comment: comment,
+	}
+
+	return c.send(ctx, req, nil)
+}
+
+// DeleteToken by id.
+func (c *Client) DeleteToken(id string) error {
+	return c.DeleteTokenContext(context.Background(), id)
+}
+
+// DeleteTokenContext same as DeleteToken with context.
+func (c *Client) DeleteTokenContext(ctx context.Context, id string) error {
+	if id == "" {
+		return ErrTokenIDRequired
+	}
+
+	req := struct {
+		m.Request
+		ID string `json:"id"`
+	}{
+		Request: m.Request{Command: cmdAuthorize, Subcommand: authDeleteToken},
+		ID:      id,
+	}
+
+	return c.send(ctx, req, nil)
+}
+
+// TokenList returns all tokens, secret token values are not provided.
+func (c *Client) TokenList() ([]model.Token, error) {
+	return c.TokenListContext(context.Background())
+}
+
+// TokenListContext same as TokenList with context.
+func (c *Client) TokenListContext(ctx context.Context) ([]model.Token, error) {
+	var resp []model.Token
+	return resp, c.send(ctx, m.Request{Command: cmdAuthorize, Subcommand: authTokenList}, &resp)
+}
+
+// RequestToken asks admin to approve new token in Hyperion web UI and waits for decision,
+// id of 5 characters is generated when empty. Approved token is used by client and saved to token store.
+// Request is renewed when it expires on server, it requires TCP or WebSocket connection
+// since Hyperion responds only after decision.
+func (c *Client) RequestToken(id, comment string) (*model.Token, error) {
+	return c.RequestTokenContext(context.Background(), id, comment)
+}
+
+// RequestTokenContext same as RequestToken with context,
+// pending request is cancelled on server when context is done.
+func (c *Client) RequestTokenContext(ctx context.Context, id, comment string) (*model.Token, error) {
+	// HTTP request times out before admin decision
+	if _, ok := c.transport.(*httpTransport); ok {
+		return nil, ErrTokenRequestNotAllowed
+	}
+
+	if id == "" {
+		id = tokenRequestID()
+	}
+
+	if len(id) != 5 {
+		return nil, ErrTokenIDLength
+	}
+
+	if len(comment) < 10 {
+		return nil, ErrCommentLength
+	}
+
+	req := tokenRequest{
+		Request: m.Request{Command: cmdAuthorize, Subcommand: authRequestToken},
+		Comment: comment,
+		ID:      id,
+	}
+
+	for {
+		start := time.Now()
+		reqCtx, cancel := context.WithTimeout(ctx, tokenRequestExpiry+tokenRequestGrace)
+
+		resp := &model.Token{}
+		err := c.send(reqCtx, req, resp)
+		cancel()
+
+		if err == nil {
+			c.acceptToken(ctx, resp.Token)
+			return resp, nil
+		}
+
+		if ctx.Err() != nil {
+			c.cancelTokenRequest(req)
+			return nil, err
+		}
+
+		if errors.Is(err, ErrCommandFailed) && time.Since(start) < tokenRequestExpiry {
+			return nil, fmt.Errorf("%w: %w", ErrTokenDenied, err)
+		}
+
+		if !errors.Is(err, ErrCommandFailed) && !errors.Is(err, context.DeadlineExceeded) {
+			return nil, err
+		}
+
+		// request is expired, ask again
+		c.cancelTokenRequest(req)
+	}
+}
+
+type tokenRequest struct {
+	m.Request
+	Comment string `json:"comment"`
+	ID      string `json:"id"`
+	Accept  *bool  `json:"accept,omitempty"`
+}
+
+// acceptToken use approved token for next requests and save it.
+func (c *Client) acceptToken(ctx context.Context, token string) {
+	c.token.set(token)
+
+	if c.tokenStore != nil {
+		if err := c.tokenStore.Save(token); err != nil {
+			c.logger.Warn("could not save token: " + err.Error())
+		}
+	}
+
+	// authorize current session of persistent connection
+	req := struct {
+		m.Request
+		Token string `json:"token"`
+	}{
+		Request: m.Request{Command: cmdAuthorize, Subcommand: authLogin},
+		Token:   token,
+	}
+
+	if err := c.send(ctx, req, nil); err != nil {
+		c.logger.Warn("could not login with token: " + err.Error())
+	}
+}
+
+// cancelTokenRequest on server, Hyperion does not respond to it.
+func (c *Client) cancelTokenRequest(req tokenRequest) {
+	accept := false
+	req.Accept = &accept
+
+	ctx, cancel := context.WithTimeout(context.Background(), tokenCancelTimeout)
+	defer cancel()
+
+	_ = c.send(ctx, req, nil)
+}
+
+// tokenRequestID generates 5 random characters.
+func tokenRequestID() string {
+	const chars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+	id := make([]byte, 5)
+	for i := range id {
+		id[i] = chars[rand.IntN(len(chars))]
+	}
+
+	return string(id)
+}
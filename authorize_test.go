@@ -0,0 +1,147 @@
+package hyperion
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/denwwer/hyperion-ng/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenRequired(t *testing.T) {
+	t.Parallel()
+	c := testClient()
+
+	required, err := c.TokenRequired()
+	require.Nil(t, err)
+	assert.True(t, required)
+
+	required, err = c.AdminRequired()
+	require.Nil(t, err)
+	assert.True(t, required)
+}
+
+func TestLogin(t *testing.T) {
+	t.Parallel()
+	c := testClient()
+
+	assert.ErrorIs(t, c.Login(""), ErrPasswordRequired)
+
+	require.Nil(t, c.Login("hyperion"))
+	assert.Equal(t, "5c7ed1a3-9a5e-4f5c-a7ff-3f5a7ce0f5b9", c.token.get())
+
+	require.Nil(t, c.NewPassword("hyperion", "secret"))
+
+	require.Nil(t, c.Logout())
+	assert.Empty(t, c.token.get())
+}
+
+func TestTokens(t *testing.T) {
+	t.Parallel()
+	c := testClient()
+
+	token, err := c.CreateToken("provisioning")
+	require.Nil(t, err)
+	assert.Equal(t, &model.Token{ID: "b3a1f", Comment: "provisioning", Token: "0e6f1c7b-2d38-4c5e-9a0f-6b3c2f0e4d21"}, token)
+
+	require.Nil(t, c.RenameToken(token.ID, "provisioning tool"))
+	assert.ErrorIs(t, c.RenameToken("", "provisioning tool"), ErrTokenIDRequired)
+
+	tokens, err := c.TokenList()
+	require.Nil(t, err)
+	require.Len(t, tokens, 2)
+	assert.Equal(t, "2024-07-29T19:46:40Z", tokens[0].LastUse)
+	assert.Empty(t, tokens[0].Token)
+
+	require.Nil(t, c.DeleteToken(token.ID))
+}
+
+func TestRequestToken(t *testing.T) {
+	t.Parallel()
+
+	store := FileTokenStore(filepath.Join(t.TempDir(), "hyperion", "token"))
+	c := testTCPClient(WithTokenStore(store))
+	defer c.Close()
+
+	_, err := c.RequestToken("abc", "Kiosk in the hall")
+	assert.ErrorIs(t, err, ErrTokenIDLength)
+
+	token, err := c.RequestToken("K1osk", "Kiosk in the hall")
+	require.Nil(t, err)
+	assert.Equal(t, "7d1f0c8e-4b6a-4f3e-8c2d-9a5b1e6f3c70", token.Token)
+	assert.Equal(t, token.Token, c.token.get())
+
+	saved, err := store.Load()
+	require.Nil(t, err)
+	assert.Equal(t, token.Token, saved)
+
+	// token is loaded on next run
+	c = NewClient(Config{}, WithTokenStore(store))
+	assert.Equal(t, token.Token, c.token.get())
+}
+
+func TestRequestTokenHTTP(t *testing.T) {
+	t.Parallel()
+	c := testClient()
+
+	_, err := c.RequestToken("K1osk", "Kiosk in the hall")
+	assert.ErrorIs(t, err, ErrTokenRequestNotAllowed)
+}
+
+func TestRequestTokenDenied(t *testing.T) {
+	t.Parallel()
+
+	c := NewClient(Config{}, WithTransport(TransportFunc(func(ctx context.Context, req []byte) ([]byte, error) {
+		return []byte(`{"command":"authorize-requestToken","success":false,"error":"Token request timeout or denied"}`), nil
+	})))
+
+	_, err := c.RequestToken("", "Kiosk in the hall")
+	assert.ErrorIs(t, err, ErrTokenDenied)
+}
+
+func TestRequestTokenCancel(t *testing.T) {
+	t.Parallel()
+
+	cancelled := make(chan map[string]interface{}, 1)
+	c := NewClient(Config{}, WithTransport(TransportFunc(func(ctx context.Context, req []byte) ([]byte, error) {
+		data := map[string]interface{}{}
+		json.Unmarshal(req, &data)
+
+		if data["accept"] == false {
+			cancelled <- data
+			return []byte(`{"success":true}`), nil
+		}
+
+		<-ctx.Done() // wait for approval
+		return nil, ctx.Err()
+	})))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := c.RequestTokenContext(ctx, "K1osk", "Kiosk in the hall")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	req := <-cancelled
+	assert.Equal(t, "requestToken", req["subcommand"])
+	assert.Equal(t, "K1osk", req["id"])
+}
+
+func TestEnvTokenStore(t *testing.T) {
+	store := EnvTokenStore("HYPERION_TEST_TOKEN")
+	t.Setenv(string(store), "")
+
+	require.Nil(t, store.Save("6c224a4c"))
+
+	c := NewClient(Config{}, WithTokenStore(store))
+	assert.Equal(t, "6c224a4c", c.token.get())
+
+	// configured token takes precedence
+	c = NewClient(Config{Connection: Connection{Token: "configured"}}, WithTokenStore(store))
+	assert.Equal(t, "configured", c.token.get())
+}
@@ -0,0 +1,267 @@
+// Package boblight implements client for Hyperion Boblight server,
+// it is a simple line based protocol where LEDs are addressed as named lights.
+package boblight
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"image/color"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultPort of Hyperion Boblight server.
+const DefaultPort = 19333
+
+// Validation errors.
+var (
+	ErrPriorityRequired = errors.New("priority is required")
+	ErrColorsCount      = errors.New("colors count does not match lights count")
+	ErrUnknownLight     = errors.New("unknown light")
+)
+
+// Config for Boblight client.
+type Config struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port"`     // Default is 19333
+	Timeout  int    `json:"timeout"`  // Time to wait for a server response in seconds (default 30 sec)
+	Priority int    `json:"priority"` // Priority of colors
+}
+
+// Light is a single LED with its scan area in percent of image.
+type Light struct {
+	Name   string
+	Top    float64
+	Bottom float64
+	Left   float64
+	Right  float64
+}
+
+// Client for Hyperion Boblight server,
+// connection is established on demand, so it is restored after failures.
+type Client struct {
+	addr     string
+	timeout  time.Duration
+	priority int
+
+	mu     sync.Mutex
+	conn   net.Conn
+	reader *bufio.Reader
+	lights []Light
+}
+
+// NewClient creates new client.
+func NewClient(conf Config) *Client {
+	port := conf.Port
+	if port == 0 {
+		port = DefaultPort
+	}
+
+	timeout := time.Second * 30
+	if conf.Timeout > 0 {
+		timeout = time.Second * time.Duration(conf.Timeout)
+	}
+
+	return &Client{
+		addr:     net.JoinHostPort(conf.Host, strconv.Itoa(port)),
+		timeout:  timeout,
+		priority: conf.Priority,
+	}
+}
+
+// Lights returns list of lights reported by server.
+func (c *Client) Lights() ([]Light, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.connect(); err != nil {
+		return nil, err
+	}
+
+	return c.lights, nil
+}
+
+// SetColors for every light in order of Lights.
+func (c *Client) SetColors(colors []color.Color) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.connect(); err != nil {
+		return err
+	}
+
+	if len(colors) != len(c.lights) {
+		return ErrColorsCount
+	}
+
+	values := make(map[string]color.Color, len(colors))
+	for i, clr := range colors {
+		values[c.lights[i].Name] = clr
+	}
+
+	return c.write(values)
+}
+
+// SetLights colors by light name, other lights keep their colors.
+func (c *Client) SetLights(colors map[string]color.Color) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.connect(); err != nil {
+		return err
+	}
+
+	for name := range colors {
+		if !c.hasLight(name) {
+			return fmt.Errorf("%w: %s", ErrUnknownLight, name)
+		}
+	}
+
+	return c.write(colors)
+}
+
+// Close connection.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn == nil {
+		return nil
+	}
+
+	err := c.conn.Close()
+	c.conn = nil
+	return err
+}
+
+// connect, handshake and read lights list.
+func (c *Client) connect() error {
+	if c.conn != nil {
+		return nil
+	}
+
+	if c.priority < 1 {
+		return ErrPriorityRequired
+	}
+
+	conn, err := net.DialTimeout("tcp", c.addr, c.timeout)
+	if err != nil {
+		return err
+	}
+
+	c.conn = conn
+	c.reader = bufio.NewReader(conn)
+
+	if err = c.handshake(); err != nil {
+		c.reset()
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) handshake() error {
+	if err := c.conn.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+		return err
+	}
+
+	if line, err := c.request("hello"); err != nil {
+		return err
+	} else if line != "hello" {
+		return fmt.Errorf("unexpected handshake response: %s", line)
+	}
+
+	line, err := c.request("get lights")
+	if err != nil {
+		return err
+	}
+
+	var count int
+	if _, err = fmt.Sscanf(line, "lights %d", &count); err != nil {
+		return fmt.Errorf("unexpected lights response: %s", line)
+	}
+
+	c.lights = make([]Light, 0, count)
+
+	for i := 0; i < count; i++ {
+		if line, err = c.readLine(); err != nil {
+			return err
+		}
+
+		light := Light{}
+		if _, err = fmt.Sscanf(line, "light %s scan %g %g %g %g", &light.Name, &light.Top, &light.Bottom, &light.Left, &light.Right); err != nil {
+			return fmt.Errorf("unexpected light response: %s", line)
+		}
+
+		c.lights = append(c.lights, light)
+	}
+
+	_, err = fmt.Fprintf(c.conn, "set priority %d\n", c.priority)
+	return err
+}
+
+// write colors and sync them.
+func (c *Client) write(colors map[string]color.Color) error {
+	b := strings.Builder{}
+
+	for _, light := range c.lights {
+		clr, ok := colors[light.Name]
+		if !ok {
+			continue
+		}
+
+		p := color.RGBAModel.Convert(clr).(color.RGBA)
+		fmt.Fprintf(&b, "set light %s rgb %.6f %.6f %.6f\n", light.Name, float64(p.R)/255, float64(p.G)/255, float64(p.B)/255)
+	}
+
+	b.WriteString("sync\n")
+
+	if err := c.conn.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+		c.reset()
+		return err
+	}
+
+	if _, err := c.conn.Write([]byte(b.String())); err != nil {
+		c.reset() // reconnect on next request
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) request(cmd string) (string, error) {
+	if _, err := c.conn.Write([]byte(cmd + "\n")); err != nil {
+		return "", err
+	}
+
+	return c.readLine()
+}
+
+func (c *Client) readLine() (string, error) {
+	line, err := c.reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(line), nil
+}
+
+func (c *Client) hasLight(name string) bool {
+	for _, light := range c.lights {
+		if light.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *Client) reset() {
+	c.conn.Close()
+	c.conn = nil
+	c.reader = nil
+}
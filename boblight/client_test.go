@@ -0,0 +1,107 @@
+package boblight
+
+import (
+	"bufio"
+	"fmt"
+	"image/color"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+	c, lines := testClient(t)
+	defer c.Close()
+
+	lights, err := c.Lights()
+	require.Nil(t, err)
+	assert.Equal(t, []Light{
+		{Name: "001", Top: 0, Bottom: 10, Left: 0, Right: 50},
+		{Name: "002", Top: 0, Bottom: 10, Left: 50, Right: 100},
+	}, lights)
+
+	require.Nil(t, c.SetColors([]color.Color{color.White, color.RGBA{R: 255, A: 255}}))
+	assert.Equal(t, []string{
+		"hello",
+		"get lights",
+		"set priority 128",
+		"set light 001 rgb 1.000000 1.000000 1.000000",
+		"set light 002 rgb 1.000000 0.000000 0.000000",
+		"sync",
+	}, testReadLines(t, lines, 6))
+
+	require.Nil(t, c.SetLights(map[string]color.Color{"002": color.Black}))
+	assert.Equal(t, []string{
+		"set light 002 rgb 0.000000 0.000000 0.000000",
+		"sync",
+	}, testReadLines(t, lines, 2))
+}
+
+func TestClientError(t *testing.T) {
+	t.Parallel()
+	c, _ := testClient(t)
+	defer c.Close()
+
+	assert.ErrorIs(t, c.SetColors([]color.Color{color.White}), ErrColorsCount)
+	assert.ErrorIs(t, c.SetLights(map[string]color.Color{"003": color.White}), ErrUnknownLight)
+
+	c = NewClient(Config{Host: "127.0.0.1"})
+	_, err := c.Lights()
+	assert.ErrorIs(t, err, ErrPriorityRequired)
+}
+
+// testClient with Boblight stand-in server, all received lines are sent to channel.
+func testClient(t *testing.T) (*Client, chan string) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	lines := make(chan string, 100)
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		scanner := bufio.NewScanner(conn)
+		for scanner.Scan() {
+			line := scanner.Text()
+			lines <- line
+
+			switch line {
+			case "hello":
+				fmt.Fprint(conn, "hello\n")
+			case "get lights":
+				fmt.Fprint(conn, "lights 2\nlight 001 scan 0 10 0 50\nlight 002 scan 0 10 50 100\n")
+			}
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(l.Addr().String())
+	p, _ := strconv.Atoi(port)
+
+	return NewClient(Config{Host: host, Port: p, Priority: 128}), lines
+}
+
+func testReadLines(t *testing.T, lines chan string, n int) []string {
+	var res []string
+
+	for i := 0; i < n; i++ {
+		select {
+		case line := <-lines:
+			res = append(res, strings.TrimSpace(line))
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for line")
+		}
+	}
+
+	return res
+}
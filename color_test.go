@@ -0,0 +1,104 @@
+package hyperion
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/denwwer/hyperion-ng/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColor(t *testing.T) {
+	t.Parallel()
+
+	orange := model.RGB(255, 136, 0)
+
+	c, err := model.Hex("#ff8800")
+	require.Nil(t, err)
+	assert.Equal(t, orange, c)
+
+	c, err = model.Hex("f80")
+	require.Nil(t, err)
+	assert.Equal(t, orange, c)
+	assert.Equal(t, "#ff8800", c.Hex())
+
+	_, err = model.Hex("#ff88")
+	assert.ErrorIs(t, err, model.ErrColorHex)
+
+	c, err = model.Named("RebeccaPurple")
+	require.Nil(t, err)
+	assert.Equal(t, model.RGB(0x66, 0x33, 0x99), c)
+
+	_, err = model.Named("unknown")
+	assert.ErrorIs(t, err, model.ErrColorName)
+
+	c, err = model.HSL(120, 1, 0.5)
+	require.Nil(t, err)
+	assert.Equal(t, model.RGB(0, 255, 0), c)
+
+	h, s, l := model.RGB(255, 0, 0).HSL()
+	assert.Equal(t, []float64{0, 1, 0.5}, []float64{h, s, l})
+
+	c, err = model.HSV(240, 1, 1)
+	require.Nil(t, err)
+	assert.Equal(t, model.RGB(0, 0, 255), c)
+
+	_, err = model.HSV(0, 2, 1)
+	assert.ErrorIs(t, err, model.ErrColorRange)
+
+	c, err = model.Kelvin(6600)
+	require.Nil(t, err)
+	assert.Equal(t, model.RGB(255, 255, 255), c)
+
+	c, err = model.Kelvin(2700)
+	require.Nil(t, err)
+	assert.Equal(t, uint8(255), c.R)
+	assert.Less(t, c.B, c.G)
+
+	_, err = model.Kelvin(500)
+	assert.ErrorIs(t, err, model.ErrColorKelvin)
+
+	data, err := json.Marshal(model.Colors{orange, model.RGB(0, 0, 1)})
+	require.Nil(t, err)
+	assert.JSONEq(t, `[[255,136,0],[0,0,1]]`, string(data))
+
+	var colors model.Colors
+	require.Nil(t, json.Unmarshal(data, &colors))
+	assert.Equal(t, []int{255, 136, 0, 0, 0, 1}, colors.Ints())
+
+	assert.ErrorIs(t, json.Unmarshal([]byte(`[256,0,0]`), &c), model.ErrColorRange)
+}
+
+func TestSetColors(t *testing.T) {
+	t.Parallel()
+	c := testClient()
+
+	err := c.SetColors([]model.Color{model.RGB(255, 0, 0), model.RGB(0, 0, 255)}, 20, "test 1", nil)
+	require.Nil(t, err)
+
+	err = c.SetColors(nil, 20, "test 1", nil)
+	assert.ErrorIs(t, err, ErrColorRequired)
+
+	err = c.SetColor([]int{255, 0, 0, 255}, 20, "test 1", nil)
+	assert.ErrorIs(t, err, ErrColorInvalid)
+
+	err = c.SetColor([]int{256, 0, 0}, 20, "test 1", nil)
+	assert.ErrorIs(t, err, ErrValidation)
+}
+
+func TestPriorityColor(t *testing.T) {
+	t.Parallel()
+	c := testClient()
+
+	info, err := c.ServerInfo()
+	require.Nil(t, err)
+
+	color, ok := info.Priorities[0].Color()
+	require.True(t, ok)
+	assert.Equal(t, model.RGB(0, 0, 0), color)
+
+	_, ok = model.Priority{}.Color()
+	assert.False(t, ok)
+}
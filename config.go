@@ -7,9 +7,9 @@ type ConnectionType string
 
 // List of Hyperion connection mechanisms.
 const (
-	// ConnectTCP       ConnectionType = "TCP"  // TCP Socket
-	// ConnectWebSocket ConnectionType = "WS"   // WebSocket
-	ConnectHTTP ConnectionType = "HTTP" // HTTP/S
+	ConnectTCP       ConnectionType = "TCP"  // TCP Socket
+	ConnectWebSocket ConnectionType = "WS"   // WebSocket
+	ConnectHTTP      ConnectionType = "HTTP" // HTTP/S
 )
 
 // Config for client.
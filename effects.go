@@ -0,0 +1,80 @@
+package hyperion
+
+import (
+	"context"
+
+	m "github.com/denwwer/hyperion-ng/internal/model"
+	"github.com/denwwer/hyperion-ng/model"
+)
+
+const (
+	cmdCreateEffect = "create-effect"
+	cmdDeleteEffect = "delete-effect"
+)
+
+// Effect management errors.
+var (
+	ErrEffectNameRequired   error = validationError("effect name is required")
+	ErrEffectScriptRequired error = validationError("effect script is required")
+)
+
+// CreateEffect stores user effect, imageB64 is optional image used by effect script as Base64.
+// Use Effect.Derive to create effect from existing one.
+func (c Client) CreateEffect(effect model.Effect, imageB64 string) error {
+	return c.CreateEffectContext(context.Background(), effect, imageB64)
+}
+
+// CreateEffectContext same as CreateEffect with context.
+func (c Client) CreateEffectContext(ctx context.Context, effect model.Effect, imageB64 string) error {
+	if effect.Name == "" {
+		return ErrEffectNameRequired
+	}
+
+	if effect.Script == "" {
+		return ErrEffectScriptRequired
+	}
+
+	req := struct {
+		m.Request
+		Name      string                 `json:"name"`
+		Script    string                 `json:"script"`
+		Args      map[string]interface{} `json:"args"`
+		ImageData string                 `json:"imageData,omitempty"`
+	}{
+		Request:   m.Request{Command: cmdCreateEffect},
+		Name:      effect.Name,
+		Script:    effect.Script,
+		Args:      effect.Args,
+		ImageData: imageB64,
+	}
+
+	if req.Args == nil {
+		req.Args = map[string]interface{}{}
+	}
+
+	defer c.effectCache.reset()
+	return c.send(ctx, req, nil)
+}
+
+// DeleteEffect removes user effect by name.
+func (c Client) DeleteEffect(name string) error {
+	return c.DeleteEffectContext(context.Background(), name)
+}
+
+// DeleteEffectContext same as DeleteEffect with context.
+func (c Client) DeleteEffectContext(ctx context.Context, name string) error {
+	if name == "" {
+		return ErrEffectNameRequired
+	}
+
+	req := struct {
+		m.Request
+		Name string `json:"name"`
+	}{
+		Request: m.Request{Command: cmdDeleteEffect},
+		Name:    name,
+	}
+
+	defer c.effectCache.reset()
+	return c.send(ctx, req, nil)
+}
@@ -0,0 +1,56 @@
+package hyperion
+
+import (
+	"testing"
+
+	"github.com/denwwer/hyperion-ng/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateEffect(t *testing.T) {
+	t.Parallel()
+
+	c, recorded := testMemClient(nil)
+
+	info, err := c.ServerInfo()
+	require.Nil(t, err)
+
+	var blobs model.Effect
+	for _, effect := range info.Effects.System() {
+		if effect.Name == "Blue mood blobs" {
+			blobs = effect
+		}
+	}
+
+	effect := blobs.Derive("Warm mood blobs", map[string]interface{}{"hueChange": 30})
+	assert.Empty(t, effect.File)
+	assert.Equal(t, ":/effects/mood-blobs.py", effect.Script)
+	assert.Equal(t, float64(60), blobs.Args["hueChange"]) // origin is not changed
+
+	require.Nil(t, c.CreateEffect(effect, ""))
+	require.Nil(t, c.DeleteEffect("Warm mood blobs"))
+
+	requests := recorded.all()
+	require.Len(t, requests, 3)
+	assert.Equal(t, "create-effect", requests[1]["command"])
+	assert.Equal(t, "Warm mood blobs", requests[1]["name"])
+	assert.Equal(t, ":/effects/mood-blobs.py", requests[1]["script"])
+	assert.NotContains(t, requests[1], "imageData")
+
+	args := requests[1]["args"].(map[string]interface{})
+	assert.Equal(t, float64(30), args["hueChange"])
+	assert.Equal(t, blobs.Args["rotationTime"], args["rotationTime"])
+
+	assert.Equal(t, map[string]interface{}{"command": "delete-effect", "name": "Warm mood blobs"}, requests[2])
+}
+
+func TestCreateEffectError(t *testing.T) {
+	t.Parallel()
+	c := testClient()
+
+	assert.ErrorIs(t, c.CreateEffect(model.Effect{Script: ":/effects/fade.py"}, ""), ErrEffectNameRequired)
+	assert.ErrorIs(t, c.CreateEffect(model.Effect{Name: "Fade"}, ""), ErrValidation)
+	assert.ErrorIs(t, c.DeleteEffect(""), ErrEffectNameRequired)
+}
@@ -0,0 +1,228 @@
+package hyperion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sync"
+
+	"github.com/denwwer/hyperion-ng/model"
+)
+
+// ErrEffectUnknown is returned by ValidateEffect for effect which is not provided by Hyperion.
+var ErrEffectUnknown error = validationError("effect is unknown")
+
+// EffectArgError describes invalid argument of effect, it matches ErrValidation.
+type EffectArgError struct {
+	Effect string
+	Arg    string
+	Reason string
+}
+
+func (e *EffectArgError) Error() string {
+	return fmt.Sprintf("effect %q: argument %q %s", e.Effect, e.Arg, e.Reason)
+}
+
+// Is reports whether target is ErrValidation.
+func (e *EffectArgError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// WithEffectValidation validate effect arguments locally by SetEffect,
+// schemas are fetched once and refreshed after CreateEffect or DeleteEffect.
+func WithEffectValidation() ClientOption {
+	return func(c *Client) {
+		c.effectValidation = true
+	}
+}
+
+// EffectSchemas retrieve argument schemas of effect scripts.
+func (c *Client) EffectSchemas() (model.EffectSchemas, error) {
+	return c.EffectSchemasContext(context.Background())
+}
+
+// EffectSchemasContext same as EffectSchemas with context.
+func (c *Client) EffectSchemasContext(ctx context.Context) (model.EffectSchemas, error) {
+	schema, err := c.GetSchemaContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	schemas := model.EffectSchemas{}
+	if err = json.Unmarshal(schema, &schemas); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDecode, err)
+	}
+
+	return schemas, nil
+}
+
+// ValidateEffect arguments by schema of effect script,
+// all invalid arguments are reported as EffectArgError.
+func (c Client) ValidateEffect(effect model.Effect) error {
+	return c.ValidateEffectContext(context.Background(), effect)
+}
+
+// ValidateEffectContext same as ValidateEffect with context.
+func (c Client) ValidateEffectContext(ctx context.Context, effect model.Effect) error {
+	schemas, scripts, err := c.effectCache.load(ctx, &c)
+	if err != nil {
+		return err
+	}
+
+	script := effect.Script
+	if script == "" {
+		if script = scripts[effect.Name]; script == "" {
+			return fmt.Errorf("%w: %s", ErrEffectUnknown, effect.Name)
+		}
+	}
+
+	schema := schemas.Find(script)
+	if schema == nil {
+		return nil // nothing to validate against
+	}
+
+	return validateArgs(effect.Name, *schema, effect.Args)
+}
+
+// validateArgs checks arguments by schema.
+func validateArgs(effect string, schema model.EffectSchema, args map[string]interface{}) error {
+	// normalize values to JSON types
+	b, err := json.Marshal(args)
+	if err != nil {
+		return err
+	}
+
+	values := map[string]interface{}{}
+	if err = json.Unmarshal(b, &values); err != nil {
+		return err
+	}
+
+	var errs []error
+	for name, value := range values {
+		param := schema.Param(name)
+		if param == nil {
+			if !schema.Additional {
+				errs = append(errs, &EffectArgError{Effect: effect, Arg: name, Reason: "is unknown"})
+			}
+			continue
+		}
+
+		if reason := validateArg(*param, value); reason != "" {
+			errs = append(errs, &EffectArgError{Effect: effect, Arg: name, Reason: reason})
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateArg returns reason of invalid value.
+func validateArg(param model.EffectParam, value interface{}) string {
+	if value == nil {
+		return "" // default is used
+	}
+
+	if len(param.Enum) > 0 && !containsValue(param.Enum, value) {
+		return fmt.Sprintf("should be one of %v", param.Enum)
+	}
+
+	switch param.Type {
+	case "integer", "number":
+		n, ok := value.(float64)
+		if !ok || (param.Type == "integer" && n != math.Trunc(n)) {
+			return "should be " + param.Type
+		}
+
+		if param.Minimum != nil && n < *param.Minimum {
+			return fmt.Sprintf("should be >= %v", *param.Minimum)
+		}
+
+		if param.Maximum != nil && n > *param.Maximum {
+			return fmt.Sprintf("should be <= %v", *param.Maximum)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return "should be boolean"
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			return "should be string"
+		}
+	case "array":
+		items, ok := value.([]interface{})
+		if !ok {
+			return "should be array"
+		}
+
+		if param.MinItems != nil && len(items) < *param.MinItems {
+			return fmt.Sprintf("should have at least %d items", *param.MinItems)
+		}
+
+		if param.MaxItems != nil && len(items) > *param.MaxItems {
+			return fmt.Sprintf("should have at most %d items", *param.MaxItems)
+		}
+
+		if param.Items == nil {
+			return ""
+		}
+
+		for i, item := range items {
+			if reason := validateArg(*param.Items, item); reason != "" {
+				return fmt.Sprintf("item %d %s", i, reason)
+			}
+		}
+	}
+
+	return ""
+}
+
+func containsValue(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// effectCache keeps effect schemas and scripts of effects for local validation.
+type effectCache struct {
+	mu      sync.Mutex
+	schemas model.EffectSchemas
+	scripts map[string]string // script by effect name
+}
+
+func (e *effectCache) load(ctx context.Context, c *Client) (model.EffectSchemas, map[string]string, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.schemas != nil {
+		return e.schemas, e.scripts, nil
+	}
+
+	schemas, err := c.EffectSchemasContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info, err := c.ServerInfoContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scripts := map[string]string{}
+	for _, effect := range info.Effects {
+		scripts[effect.Name] = effect.Script
+	}
+
+	e.schemas, e.scripts = schemas, scripts
+	return schemas, scripts, nil
+}
+
+func (e *effectCache) reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.schemas, e.scripts = nil, nil
+}
@@ -0,0 +1,104 @@
+package hyperion
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/denwwer/hyperion-ng/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEffectSchemas(t *testing.T) {
+	t.Parallel()
+	c := testClient()
+
+	schemas, err := c.EffectSchemas()
+	require.Nil(t, err)
+
+	schema := schemas.Find(":/effects/mood-blobs.py")
+	require.NotNil(t, schema)
+	assert.False(t, schema.Additional)
+	assert.Equal(t, "rotationTime", schema.Params[0].Name)
+
+	blobs := schema.Param("blobs")
+	require.NotNil(t, blobs)
+	assert.Equal(t, "integer", blobs.Type)
+	assert.Equal(t, float64(5), blobs.Default)
+	assert.Equal(t, float64(1), *blobs.Minimum)
+	assert.Equal(t, float64(10), *blobs.Maximum)
+
+	assert.Equal(t, "integer", schema.Param("color").Items.Type)
+}
+
+func TestValidateEffect(t *testing.T) {
+	t.Parallel()
+	c := testClient()
+
+	err := c.ValidateEffect(model.Effect{
+		Name: "Blue mood blobs",
+		Args: map[string]interface{}{"blobs": 11, "color": []int{0, 0, 300}, "blob": 1, "reverse": true},
+	})
+
+	var argErr *EffectArgError
+	require.ErrorAs(t, err, &argErr)
+	assert.ErrorIs(t, err, ErrValidation)
+	assert.Contains(t, err.Error(), `effect "Blue mood blobs": argument "blobs" should be <= 10`)
+	assert.Contains(t, err.Error(), `argument "color" item 2 should be <= 255`)
+	assert.Contains(t, err.Error(), `argument "blob" is unknown`)
+
+	blobs, hue := 7, 30.0
+	err = c.ValidateEffect(model.Effect{
+		Name: "Blue mood blobs",
+		Args: model.MoodBlobsArgs{Blobs: &blobs, HueChange: &hue, Color: []int{255, 0, 0}}.Args(),
+	})
+	assert.Nil(t, err)
+
+	// schema of script is not provided
+	assert.Nil(t, c.ValidateEffect(model.Effect{Name: "Candle", Args: map[string]interface{}{"any": 1}}))
+
+	err = c.ValidateEffect(model.Effect{Name: "Unknown"})
+	assert.ErrorIs(t, err, ErrEffectUnknown)
+}
+
+func TestEffectSchemasSnapshot(t *testing.T) {
+	t.Parallel()
+	c := testClient()
+
+	b, err := os.ReadFile("internal/cmd/effectgen/effectschemas.json")
+	require.Nil(t, err)
+
+	var schemas model.EffectSchemas
+	require.Nil(t, json.Unmarshal(b, &schemas))
+
+	info, err := c.ServerInfo()
+	require.Nil(t, err)
+
+	// typed arguments are generated for every system effect
+	for _, effect := range info.Effects.System() {
+		schema := schemas.Find(effect.Script)
+		if assert.NotNil(t, schema, effect.Script) {
+			assert.Nil(t, validateArgs(effect.Name, *schema, effect.Args))
+		}
+	}
+}
+
+func TestSetEffectValidation(t *testing.T) {
+	t.Parallel()
+	c := testClient(WithEffectValidation())
+
+	err := c.SetEffect(model.Effect{
+		Name: "Breath",
+		Args: map[string]interface{}{"repeat-count": 1.5, "maintain-end-color": "yes"},
+	}, 20, "test 1", nil)
+	assert.Equal(t, 2, len(err.(interface{ Unwrap() []error }).Unwrap()))
+	assert.ErrorIs(t, err, ErrValidation)
+
+	err = c.SetEffect(model.Effect{
+		Name: "Breath",
+		Args: map[string]interface{}{"repeat-count": 2},
+	}, 20, "test 1", nil)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,116 @@
+package hyperion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	m "github.com/denwwer/hyperion-ng/internal/model"
+	"github.com/denwwer/hyperion-ng/model"
+)
+
+// Sentinel errors, use errors.Is to check kind of failure.
+var (
+	ErrAuthRequired  = errors.New("authorization is required") // Token is missing, invalid or expired
+	ErrValidation    = errors.New("validation failed")         // Arguments are rejected before sending
+	ErrTransport     = errors.New("transport failed")          // Request could not be delivered
+	ErrDecode        = errors.New("could not decode response") // Response is not valid JSON
+	ErrHTTPStatus    = errors.New("unexpected HTTP status")    // HTTP response status is not 2xx
+	ErrCommandFailed = errors.New("command failed")            // Hyperion processed command with error
+)
+
+// Validation errors, all of them match ErrValidation.
+var (
+	ErrColorRequired    error = validationError("color is required")
+	ErrColorInvalid     error = validationError("color should be R, G, B values in range 0-255")
+	ErrPriorityRequired error = validationError("priority is required")
+	ErrOriginRequired   error = validationError("origin is required")
+	ErrDurationRequired error = validationError("duration should be >= 0")
+)
+
+type validationError string
+
+func (e validationError) Error() string {
+	return string(e)
+}
+
+func (e validationError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// APIError describes failed command, Err is one of sentinel errors (possibly wrapping the cause).
+type APIError struct {
+	Command    string           // Command name
+	Tan        int              // Transaction number of response
+	Instance   int              // Instance which processed command
+	StatusCode int              // HTTP status, 0 for other connection types
+	Message    string           // Error message from Hyperion
+	Logs       []model.LogEntry // Recent Hyperion log, see WithErrorLogs
+	Err        error
+}
+
+// Error returns message from Hyperion or description of failure.
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+
+	return fmt.Sprintf("%s: %s", e.Command, e.Err)
+}
+
+// Unwrap returns sentinel error.
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// StatusError is returned by HTTP transport for non 2xx response.
+type StatusError struct {
+	StatusCode int
+	Body       []byte
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("HTTP status %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// DeliveredError is returned by transport when request is sent but response is not received,
+// command could be already executed by Hyperion.
+type DeliveredError struct {
+	Err error
+}
+
+func (e *DeliveredError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *DeliveredError) Unwrap() error {
+	return e.Err
+}
+
+// DefaultRetryable retries transport errors except context cancellation, deadline,
+// failed authorization, delivered request and HTTP statuses other than 5xx.
+func DefaultRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, ErrAuthRequired) {
+		return false
+	}
+
+	// not idempotent commands, e.g. ToggleSuspend, should not be executed twice
+	var deliveredErr *DeliveredError
+	if errors.As(err, &deliveredErr) {
+		return false
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	return true
+}
+
+// isAuthError reports whether Hyperion message is about missing authorization.
+func isAuthError(msg string) bool {
+	return strings.ToLower(msg) == m.AuthError
+}
@@ -0,0 +1,113 @@
+package hyperion
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIError(t *testing.T) {
+	t.Parallel()
+	c := testClient()
+
+	err := c.SetSource(-1)
+	assert.ErrorIs(t, err, ErrCommandFailed)
+
+	apiErr := &APIError{}
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "sourceselect", apiErr.Command)
+	assert.Equal(t, 0, apiErr.Instance)
+	assert.Equal(t, "Errors during specific message validation, please consult the Hyperion Log", apiErr.Message)
+}
+
+func TestValidationError(t *testing.T) {
+	t.Parallel()
+	c := testClient()
+
+	err := c.SetColor([]int{0}, 1, "test 1", nil)
+	assert.ErrorIs(t, err, ErrValidation)
+	assert.ErrorIs(t, err, ErrColorRequired)
+
+	err = c.SetColor([]int{0, 0, 0}, 0, "test 1", nil)
+	assert.ErrorIs(t, err, ErrPriorityRequired)
+	assert.EqualError(t, err, "priority is required")
+}
+
+func TestAuthError(t *testing.T) {
+	t.Parallel()
+
+	c := NewClient(Config{}, WithTransport(TransportFunc(func(ctx context.Context, req []byte) ([]byte, error) {
+		return []byte(`{"command":"color","success":false,"error":"No Authorization","tan":3,"instance":1}`), nil
+	})))
+
+	err := c.SetColor([]int{255, 0, 0}, 20, "test 1", nil)
+	assert.ErrorIs(t, err, ErrAuthRequired)
+
+	apiErr := &APIError{}
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, &APIError{Command: "color", Tan: 3, Instance: 1, Message: "No Authorization", Err: ErrAuthRequired}, apiErr)
+}
+
+func TestTransportError(t *testing.T) {
+	t.Parallel()
+
+	cause := errors.New("connection refused")
+	c := NewClient(Config{}, WithRetryPolicy(RetryPolicy{}), WithTransport(TransportFunc(func(ctx context.Context, req []byte) ([]byte, error) {
+		return nil, cause
+	})))
+
+	_, err := c.ServerInfo()
+	assert.ErrorIs(t, err, ErrTransport)
+	assert.ErrorIs(t, err, cause)
+	assert.EqualError(t, err, "serverinfo: transport failed: connection refused")
+}
+
+func TestDecodeError(t *testing.T) {
+	t.Parallel()
+
+	c := NewClient(Config{}, WithTransport(TransportFunc(func(ctx context.Context, req []byte) ([]byte, error) {
+		return []byte(`<html>`), nil
+	})))
+
+	_, err := c.SystemInfo()
+	assert.ErrorIs(t, err, ErrDecode)
+}
+
+func TestHTTPStatusError(t *testing.T) {
+	t.Parallel()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(authHeader) == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"command":"serverinfo","success":false,"error":"No Authorization","tan":1}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer s.Close()
+
+	u, _ := url.Parse(s.URL)
+	port, _ := strconv.Atoi(u.Port())
+	conf := Config{Connection: Connection{Type: ConnectHTTP, Host: u.Hostname(), Port: port}}
+
+	_, err := NewClient(conf).ServerInfo()
+	assert.ErrorIs(t, err, ErrAuthRequired)
+
+	apiErr := &APIError{}
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+	assert.Equal(t, "No Authorization", apiErr.Message)
+
+	conf.Connection.Token = "token"
+	_, err = NewClient(conf).ServerInfo()
+	assert.ErrorIs(t, err, ErrHTTPStatus)
+	assert.EqualError(t, err, "serverinfo: unexpected HTTP status: HTTP status 404 Not Found")
+}
@@ -0,0 +1,205 @@
+// Package flatbuf implements client for Hyperion FlatBuffers server,
+// it is intended for high frame rate image and color streaming alongside JSON API client.
+package flatbuf
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/denwwer/hyperion-ng/internal/frame"
+	"github.com/denwwer/hyperion-ng/internal/rgb"
+)
+
+// DefaultPort of Hyperion FlatBuffers server.
+const DefaultPort = 19400
+
+// Validation errors.
+var (
+	ErrOriginRequired = errors.New("origin is required")
+	ErrPriorityRange  = errors.New("priority should be in range 100-199")
+	ErrImageSize      = errors.New("image data does not match size")
+)
+
+// Config for FlatBuffers client.
+type Config struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port"`     // Default is 19400
+	Timeout  int    `json:"timeout"`  // Time to wait for a server response in seconds (default 30 sec)
+	Origin   string `json:"origin"`   // Name of source visible in Hyperion
+	Priority int    `json:"priority"` // Priority of source in range 100-199
+}
+
+// Client for Hyperion FlatBuffers server,
+// connection is established and registered on demand, so it is restored after failures.
+type Client struct {
+	addr     string
+	timeout  time.Duration
+	origin   string
+	priority int
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+// NewClient creates new client.
+func NewClient(conf Config) *Client {
+	port := conf.Port
+	if port == 0 {
+		port = DefaultPort
+	}
+
+	timeout := time.Second * 30
+	if conf.Timeout > 0 {
+		timeout = time.Second * time.Duration(conf.Timeout)
+	}
+
+	return &Client{
+		addr:     net.JoinHostPort(conf.Host, strconv.Itoa(port)),
+		timeout:  timeout,
+		origin:   conf.Origin,
+		priority: conf.Priority,
+	}
+}
+
+// Priority of registered source.
+func (c *Client) Priority() int {
+	return c.priority
+}
+
+// SetColor for all LEDs, duration in milliseconds (nil is endless).
+func (c *Client) SetColor(clr color.Color, duration *int) error {
+	return c.send(colorRequest(rgb.Pack(clr), durationMS(duration)))
+}
+
+// SetImage sends image as raw RGB frame, duration in milliseconds (nil is endless).
+func (c *Client) SetImage(img image.Image, duration *int) error {
+	bounds := img.Bounds()
+	return c.SetRawImage(rgb.FromImage(img), bounds.Dx(), bounds.Dy(), duration)
+}
+
+// SetRawImage sends RGB frame (3 bytes per pixel, row by row), duration in milliseconds (nil is endless).
+func (c *Client) SetRawImage(data []byte, width, height int, duration *int) error {
+	if width < 1 || height < 1 || len(data) != width*height*3 {
+		return ErrImageSize
+	}
+
+	return c.send(imageRequest(data, width, height, durationMS(duration)))
+}
+
+// Clear registered priority.
+func (c *Client) Clear() error {
+	return c.send(clearRequest(c.priority))
+}
+
+// ClearAll priorities.
+func (c *Client) ClearAll() error {
+	return c.send(clearRequest(-1))
+}
+
+// Close connection.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn == nil {
+		return nil
+	}
+
+	err := c.conn.Close()
+	c.conn = nil
+	return err
+}
+
+func (c *Client) send(req []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn == nil {
+		if err := c.connect(); err != nil {
+			return err
+		}
+	}
+
+	if _, err := c.exchange(req); err != nil {
+		c.conn.Close()
+		c.conn = nil // reconnect on next request
+		return err
+	}
+
+	return nil
+}
+
+// connect and register source.
+func (c *Client) connect() error {
+	if len(c.origin) < 3 {
+		return ErrOriginRequired
+	}
+
+	if c.priority < 100 || c.priority > 199 {
+		return ErrPriorityRange
+	}
+
+	conn, err := net.DialTimeout("tcp", c.addr, c.timeout)
+	if err != nil {
+		return err
+	}
+
+	c.conn = conn
+
+	r, err := c.exchange(registerRequest(c.origin, c.priority))
+	if err == nil && r.Registered != c.priority {
+		err = fmt.Errorf("source is not registered with priority %d", c.priority)
+	}
+
+	if err != nil {
+		c.conn.Close()
+		c.conn = nil
+		return err
+	}
+
+	return nil
+}
+
+// exchange writes size prefixed request and waits for reply,
+// video mode notifications are skipped.
+func (c *Client) exchange(req []byte) (reply, error) {
+	if err := c.conn.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+		return reply{}, err
+	}
+
+	if err := frame.Write(c.conn, req); err != nil {
+		return reply{}, err
+	}
+
+	for {
+		buf, err := frame.Read(c.conn)
+		if err != nil {
+			return reply{}, err
+		}
+
+		r := decodeReply(buf)
+		if r.Error != "" {
+			return r, errors.New(r.Error)
+		}
+
+		if r.Video != -1 {
+			continue // video mode notification
+		}
+
+		return r, nil
+	}
+}
+
+func durationMS(duration *int) int32 {
+	if duration == nil {
+		return -1
+	}
+
+	return int32(*duration)
+}
@@ -0,0 +1,171 @@
+package flatbuf
+
+import (
+	"image"
+	"image/color"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/denwwer/hyperion-ng/internal/frame"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRequest decoded by stand-in server.
+type testRequest struct {
+	Command  byte
+	Origin   string
+	Priority int
+	Color    int
+	Duration int
+	Image    []byte
+	Width    int
+	Height   int
+}
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+	c, requests := testClient(t, 150)
+	defer c.Close()
+
+	duration := 1000
+	require.Nil(t, c.SetColor(color.RGBA{R: 255, G: 128, B: 1, A: 255}, &duration))
+
+	img := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(0, 0, color.RGBA{R: 1, G: 2, B: 3, A: 255})
+	img.Set(1, 0, color.RGBA{R: 4, G: 5, B: 6, A: 255})
+	require.Nil(t, c.SetImage(img, nil))
+
+	require.Nil(t, c.Clear())
+
+	reqs := requests()
+	require.Len(t, reqs, 4)
+	assert.Equal(t, testRequest{Command: commandRegister, Origin: "test 1", Priority: 150}, reqs[0])
+	assert.Equal(t, testRequest{Command: commandColor, Color: 0xFF8001, Duration: 1000}, reqs[1])
+	assert.Equal(t, testRequest{Command: commandImage, Image: []byte{1, 2, 3, 4, 5, 6}, Width: 2, Height: 1, Duration: -1}, reqs[2])
+	assert.Equal(t, testRequest{Command: commandClear, Priority: 150}, reqs[3])
+}
+
+func TestClientError(t *testing.T) {
+	t.Parallel()
+
+	c, _ := testClient(t, 150)
+	err := c.SetRawImage([]byte{1, 2}, 1, 1, nil)
+	assert.ErrorIs(t, err, ErrImageSize)
+
+	c, _ = testClient(t, 10)
+	err = c.SetColor(color.Black, nil)
+	assert.ErrorIs(t, err, ErrPriorityRange)
+
+	// server rejects color
+	c, _ = testClient(t, 199)
+	err = c.SetColor(color.White, nil)
+	assert.EqualError(t, err, "color is rejected")
+}
+
+func testClient(t *testing.T, priority int) (*Client, func() []testRequest) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	mu := sync.Mutex{}
+	var requests []testRequest
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			buf, err := frame.Read(conn)
+			if err != nil {
+				return
+			}
+
+			req := testDecodeRequest(buf)
+
+			mu.Lock()
+			requests = append(requests, req)
+			mu.Unlock()
+
+			switch {
+			case req.Command == commandRegister:
+				frame.Write(conn, testReply("", 0, -1))            // video mode notification
+				frame.Write(conn, testReply("", -1, req.Priority)) // registered
+			case req.Command == commandColor && req.Color == 0xFFFFFF:
+				frame.Write(conn, testReply("color is rejected", -1, -1))
+			default:
+				frame.Write(conn, testReply("", -1, -1))
+			}
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(l.Addr().String())
+	p, _ := strconv.Atoi(port)
+
+	c := NewClient(Config{Host: host, Port: p, Origin: "test 1", Priority: priority})
+
+	return c, func() []testRequest {
+		mu.Lock()
+		defer mu.Unlock()
+		return requests
+	}
+}
+
+func testDecodeRequest(buf []byte) testRequest {
+	t := table(buf)
+	req := testRequest{Command: t.GetByte(flatbuffers.UOffsetT(t.Offset(4)) + t.Pos)}
+
+	cmd := flatbuffers.Table{}
+	t.Union(&cmd, flatbuffers.UOffsetT(t.Offset(6)))
+
+	field := func(tab flatbuffers.Table, slot flatbuffers.VOffsetT, def int) int {
+		if o := tab.Offset(slot); o != 0 {
+			return int(tab.GetInt32(flatbuffers.UOffsetT(o) + tab.Pos))
+		}
+		return def
+	}
+
+	switch req.Command {
+	case commandRegister:
+		req.Origin = cmd.String(flatbuffers.UOffsetT(cmd.Offset(4)) + cmd.Pos)
+		req.Priority = field(cmd, 6, 0)
+	case commandColor:
+		req.Color = field(cmd, 4, -1)
+		req.Duration = field(cmd, 6, -1)
+	case commandImage:
+		raw := flatbuffers.Table{}
+		cmd.Union(&raw, flatbuffers.UOffsetT(cmd.Offset(6)))
+		req.Image = raw.ByteVector(flatbuffers.UOffsetT(raw.Offset(4)) + raw.Pos)
+		req.Width = field(raw, 6, -1)
+		req.Height = field(raw, 8, -1)
+		req.Duration = field(cmd, 8, -1)
+	case commandClear:
+		req.Priority = field(cmd, 4, 0)
+	}
+
+	return req
+}
+
+func testReply(errMsg string, video, registered int) []byte {
+	b := flatbuffers.NewBuilder(32)
+
+	var e flatbuffers.UOffsetT
+	if errMsg != "" {
+		e = b.CreateString(errMsg)
+	}
+
+	b.StartObject(3)
+	b.PrependInt32Slot(2, int32(registered), -1)
+	b.PrependInt32Slot(1, int32(video), -1)
+	b.PrependUOffsetTSlot(0, e, 0)
+	b.Finish(b.EndObject())
+
+	return b.FinishedBytes()
+}
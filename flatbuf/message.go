@@ -0,0 +1,115 @@
+package flatbuf
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// Messages are encoded by Hyperion schema (namespace hyperionnet):
+//
+//	table Register { origin:string (required); priority:int; }
+//	table RawImage { data:[ubyte]; width:int = -1; height:int = -1; }
+//	union ImageType { RawImage }
+//	table Image { data:ImageType (required); duration:int = -1; }
+//	table Color { data:int = -1; duration:int = -1; }
+//	table Clear { priority:int; }
+//	union Command { Color, Image, Clear, Register }
+//	table Request { command:Command (required); }
+//	table Reply { error:string; video:int = -1; registered:int = -1; }
+
+// Command union types.
+const (
+	commandColor    byte = 1
+	commandImage    byte = 2
+	commandClear    byte = 3
+	commandRegister byte = 4
+
+	imageTypeRaw byte = 1
+)
+
+// reply from Hyperion FlatBuffers server.
+type reply struct {
+	Error      string
+	Video      int
+	Registered int
+}
+
+func registerRequest(origin string, priority int) []byte {
+	b := flatbuffers.NewBuilder(64)
+	o := b.CreateString(origin)
+
+	b.StartObject(2)
+	b.PrependInt32Slot(1, int32(priority), 0)
+	b.PrependUOffsetTSlot(0, o, 0)
+
+	return finishRequest(b, commandRegister, b.EndObject())
+}
+
+func colorRequest(rgb int32, duration int32) []byte {
+	b := flatbuffers.NewBuilder(32)
+
+	b.StartObject(2)
+	b.PrependInt32Slot(1, duration, -1)
+	b.PrependInt32Slot(0, rgb, -1)
+
+	return finishRequest(b, commandColor, b.EndObject())
+}
+
+func imageRequest(rgb []byte, width, height int, duration int32) []byte {
+	b := flatbuffers.NewBuilder(len(rgb) + 64)
+	data := b.CreateByteVector(rgb)
+
+	b.StartObject(3)
+	b.PrependInt32Slot(2, int32(height), -1)
+	b.PrependInt32Slot(1, int32(width), -1)
+	b.PrependUOffsetTSlot(0, data, 0)
+	raw := b.EndObject()
+
+	b.StartObject(3)
+	b.PrependInt32Slot(2, duration, -1)
+	b.PrependUOffsetTSlot(1, raw, 0)
+	b.PrependByteSlot(0, imageTypeRaw, 0)
+
+	return finishRequest(b, commandImage, b.EndObject())
+}
+
+func clearRequest(priority int) []byte {
+	b := flatbuffers.NewBuilder(32)
+
+	b.StartObject(1)
+	b.PrependInt32Slot(0, int32(priority), 0)
+
+	return finishRequest(b, commandClear, b.EndObject())
+}
+
+func finishRequest(b *flatbuffers.Builder, typ byte, command flatbuffers.UOffsetT) []byte {
+	b.StartObject(2)
+	b.PrependUOffsetTSlot(1, command, 0)
+	b.PrependByteSlot(0, typ, 0)
+	b.Finish(b.EndObject())
+
+	return b.FinishedBytes()
+}
+
+func decodeReply(buf []byte) reply {
+	t := table(buf)
+	r := reply{Video: -1, Registered: -1}
+
+	if o := t.Offset(4); o != 0 {
+		r.Error = t.String(flatbuffers.UOffsetT(o) + t.Pos)
+	}
+
+	if o := t.Offset(6); o != 0 {
+		r.Video = int(t.GetInt32(flatbuffers.UOffsetT(o) + t.Pos))
+	}
+
+	if o := t.Offset(8); o != 0 {
+		r.Registered = int(t.GetInt32(flatbuffers.UOffsetT(o) + t.Pos))
+	}
+
+	return r
+}
+
+// table returns root table of finished buffer.
+func table(buf []byte) flatbuffers.Table {
+	return flatbuffers.Table{Bytes: buf, Pos: flatbuffers.GetUOffsetT(buf)}
+}
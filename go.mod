@@ -2,7 +2,12 @@ module github.com/denwwer/hyperion-ng
 
 go 1.23.4
 
-require github.com/stretchr/testify v1.10.0
+require (
+	github.com/google/flatbuffers v25.2.10+incompatible
+	github.com/gorilla/websocket v1.5.3
+	github.com/stretchr/testify v1.10.0
+	google.golang.org/protobuf v1.36.9
+)
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
@@ -0,0 +1,81 @@
+package hyperion
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httputil"
+)
+
+// httpTransport sends every request to Hyperion JSON-RPC endpoint.
+type httpTransport struct {
+	cl      *http.Client
+	url     string
+	header  func(header http.Header)
+	logger  Logger
+	verbose bool
+}
+
+// RoundTrip builds new HTTP request for each call, so it is safe to retry.
+func (t *httpTransport) RoundTrip(ctx context.Context, req []byte) ([]byte, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, t.url, bytes.NewReader(req))
+	if err != nil {
+		return nil, err
+	}
+
+	t.header(httpReq.Header)
+	t.logRequest(httpReq)
+
+	resp, err := t.cl.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	t.logResponse(resp)
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, &StatusError{StatusCode: resp.StatusCode, Body: body}
+	}
+
+	return body, nil
+}
+
+// Close idle connections.
+func (t *httpTransport) Close() error {
+	t.cl.CloseIdleConnections()
+	return nil
+}
+
+func (t *httpTransport) logRequest(req *http.Request) {
+	if !t.verbose {
+		return
+	}
+
+	reqLog, err := httputil.DumpRequest(req, true)
+	if err != nil {
+		t.logger.Warn("log error: " + err.Error())
+	}
+
+	t.logger.Info(">>>\n" + string(reqLog) + "\n")
+}
+
+func (t *httpTransport) logResponse(resp *http.Response) {
+	if !t.verbose {
+		return
+	}
+
+	respLog, err := httputil.DumpResponse(resp, true)
+	if err != nil {
+		t.logger.Warn("log error: " + err.Error())
+		return
+	}
+
+	t.logger.Info("<<<\n" + string(respLog))
+}
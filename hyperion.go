@@ -1,16 +1,17 @@
 package hyperion
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
-	"net/http/httputil"
-	"strings"
+	"strconv"
 	"time"
 
 	m "github.com/denwwer/hyperion-ng/internal/model"
+	"github.com/denwwer/hyperion-ng/model"
 )
 
 // Basic configuration.
@@ -18,9 +19,6 @@ const (
 	clientName   = "hyperion-ng"
 	clientHeader = "X-Client"
 	authHeader   = "Authorization"
-
-	attemptDelay = 5 * time.Second
-	attemptCount = 5
 )
 
 // ClientOption available options.
@@ -42,23 +40,34 @@ func WithHeader(headers map[string]string) ClientOption {
 
 // Client instance for Hyperion.
 type Client struct {
-	cl         *http.Client
+	transport  Transport
 	url        string
 	verboseLog bool
 	logger     Logger
 	headers    map[string]string
-	token      string
+	token      *credentials
+	retry      RetryPolicy
+	tokenStore TokenStore
+	events     *eventHub
+	errorLogs  *logTail
+
+	effectValidation bool
+	effectCache      *effectCache
+
+	instances      []int          // Set by ForInstance
+	instanceSwitch bool           // Switch instance before command of view
+	switched       *switchedState // Shared by views in switch mode
 }
 
 // NewClient creates new client.
 func NewClient(conf Config, opt ...ClientOption) *Client {
 	c := &Client{
-		cl: &http.Client{
-			Timeout: conf.GetTimeout(),
-		},
-		url:        getURL(conf),
-		verboseLog: conf.VerboseLog,
-		token:      conf.Connection.Token,
+		url:         getURL(conf),
+		verboseLog:  conf.VerboseLog,
+		token:       &credentials{token: conf.Connection.Token},
+		retry:       DefaultRetryPolicy(),
+		effectCache: &effectCache{},
+		switched:    &switchedState{},
 	}
 
 	// apply options
@@ -70,114 +79,216 @@ func NewClient(conf Config, opt ...ClientOption) *Client {
 		c.logger = &StdLogger{} // default logger
 	}
 
+	c.events = &eventHub{logger: c.logger}
+	if c.errorLogs != nil {
+		c.events.add(c.errorLogs.add, []model.EventType{model.EventLog})
+	}
+
+	if c.tokenStore != nil && c.token.get() == "" {
+		token, err := c.tokenStore.Load()
+		if err != nil {
+			c.logger.Warn("could not load token: " + err.Error())
+		}
+
+		c.token.set(token)
+	}
+
+	if c.transport == nil {
+		c.transport = c.newTransport(conf)
+	}
+
 	return c
 }
 
-func (c *Client) send(req interface{}, respInfo interface{}) error {
-	reqData, err := json.Marshal(req)
-	if err != nil {
-		return err
+// newTransport creates built-in transport by connection type.
+func (c *Client) newTransport(conf Config) Transport {
+	switch conf.Connection.Type {
+	case ConnectTCP:
+		return c.newSocket(conf, dialTCP(c.url))
+	case ConnectWebSocket:
+		header := http.Header{}
+		c.setHeader(header)
+		return c.newSocket(conf, dialWebSocket(c.url, header))
 	}
 
-	httpReq, err := http.NewRequest(http.MethodPost, c.url, bytes.NewReader(reqData))
+	return &httpTransport{
+		cl: &http.Client{
+			Timeout: conf.GetTimeout(),
+		},
+		url: c.url,
+		header: func(header http.Header) {
+			c.setHeaders(header)
+		},
+		logger:  c.logger,
+		verbose: c.verboseLog,
+	}
+}
+
+func (c *Client) newSocket(conf Config, dial func(timeout time.Duration) (frameConn, error)) *socketTransport {
+	return &socketTransport{
+		dial:           dial,
+		timeout:        conf.GetTimeout(),
+		reconnectDelay: time.Second,
+		token:          c.token,
+		logger:         c.logger,
+		verbose:        c.verboseLog,
+		push:           c.events.dispatch,
+	}
+}
+
+// Close connection to Hyperion, it is safe to call for any connection type.
+func (c *Client) Close() error {
+	return c.transport.Close()
+}
+
+func (c *Client) send(ctx context.Context, req interface{}, respInfo interface{}) error {
+	reqData, err := json.Marshal(req)
 	if err != nil {
 		return err
 	}
 
-	c.setHeaders(httpReq)
-	c.logRequest(httpReq)
+	head := m.Request{}
+	_ = json.Unmarshal(reqData, &head)
 
-	var resp *http.Response
+	var resp []byte
 	var respErr error
+	start := time.Now()
 
 	// process request and retry if failed
-	for i := 1; i <= attemptCount; i++ {
-		resp, respErr = c.cl.Do(httpReq)
-
-		c.logResponse(resp)
+	for i := 1; ; i++ {
+		resp, respErr = c.roundTrip(ctx, head.Command, reqData)
+		if respErr == nil || ctx.Err() != nil {
+			break // success or canceled
+		}
 
-		if respErr == nil {
-			break // success
+		delay, ok := c.retry.next(i, start, respErr)
+		if !ok {
+			break
 		}
 
 		// retry
 		c.logger.Warn(fmt.Sprintf("[WARN] could not connect to Hyperion [%s] (attem %d) because of error: %s", c.url, i, respErr))
-		time.Sleep(attemptDelay)
+
+		if err = sleep(ctx, delay); err != nil {
+			return &APIError{Command: head.Command, Err: fmt.Errorf("%w: %w", ErrTransport, err)}
+		}
+	}
+
+	var statusErr *StatusError
+	if errors.As(respErr, &statusErr) {
+		return c.statusError(head.Command, statusErr)
 	}
 
 	if respErr != nil {
-		return respErr // request is failed, return last error
+		// request is failed, return last error
+		return &APIError{Command: head.Command, Err: fmt.Errorf("%w: %w", ErrTransport, respErr)}
 	}
 
-	dec := json.NewDecoder(resp.Body)
 	respData := m.Response{}
 
 	if respInfo != nil {
 		respData.Info = respInfo
 	}
 
-	err = dec.Decode(&respData)
+	err = json.Unmarshal(resp, &respData)
 	if err != nil {
-		return err
+		return &APIError{Command: head.Command, Err: fmt.Errorf("%w: %w", ErrDecode, err)}
 	}
 
 	if !respData.Success {
-		if c.token == "" && strings.ToLower(respData.Error) == m.AuthError {
-			return errors.New(m.TokenRequire)
+		// request processed with error
+		apiErr := &APIError{
+			Command:  respData.Command,
+			Tan:      respData.Tan,
+			Instance: respData.Instance,
+			Message:  respData.Error,
+			Err:      ErrCommandFailed,
+		}
+
+		if apiErr.Command == "" {
+			apiErr.Command = head.Command
 		}
-		return errors.New(respData.Error) // request processed with error
+
+		if isAuthError(respData.Error) {
+			apiErr.Err = ErrAuthRequired
+		}
+
+		c.attachLogs(ctx, apiErr)
+
+		return apiErr
 	}
 
 	return nil
 }
 
-func (c Client) setHeaders(req *http.Request) {
-	if c.token != "" {
-		req.Header.Set(authHeader, "token "+c.token)
+// statusError maps non 2xx HTTP response, body is decoded if Hyperion provided the reason.
+func (c *Client) statusError(command string, statusErr *StatusError) error {
+	apiErr := &APIError{
+		Command:    command,
+		StatusCode: statusErr.StatusCode,
+		Err:        fmt.Errorf("%w: %w", ErrHTTPStatus, statusErr),
 	}
-	req.Header.Set(clientHeader, clientName)
-
-	for key, val := range c.headers {
-		req.Header.Set(key, val)
-	}
-}
 
-func (c Client) logRequest(req *http.Request) {
-	if !c.verboseLog {
-		return
+	respData := m.Response{}
+	if json.Unmarshal(statusErr.Body, &respData) == nil {
+		apiErr.Tan = respData.Tan
+		apiErr.Instance = respData.Instance
+		apiErr.Message = respData.Error
 	}
 
-	reqLog, err := httputil.DumpRequest(req, true)
-	if err != nil {
-		c.logger.Warn("log error: " + err.Error())
+	if statusErr.StatusCode == http.StatusUnauthorized || statusErr.StatusCode == http.StatusForbidden || isAuthError(apiErr.Message) {
+		apiErr.Err = fmt.Errorf("%w: %w", ErrAuthRequired, statusErr)
 	}
 
-	c.logger.Info(">>>\n" + string(reqLog) + "\n")
+	return apiErr
 }
 
-func (c Client) logResponse(resp *http.Response) {
-	if !c.verboseLog {
-		return
-	}
+// sleep for duration or until context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
 
-	if resp == nil {
-		return // empty response
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
 	}
+}
 
-	respLog, err := httputil.DumpResponse(resp, true)
-	if err != nil {
-		c.logger.Warn("log error: " + err.Error())
-		return
+func (c Client) setHeaders(header http.Header) {
+	if token := c.token.get(); token != "" {
+		header.Set(authHeader, "token "+token)
 	}
+	c.setHeader(header)
+}
 
-	c.logger.Info("<<<\n" + string(respLog))
+func (c Client) setHeader(header http.Header) {
+	header.Set(clientHeader, clientName)
+
+	for key, val := range c.headers {
+		header.Set(key, val)
+	}
 }
 
 func getURL(conf Config) string {
-	if conf.Connection.Type == ConnectHTTP {
-		schema := "http"
+	if conf.Connection.Type == ConnectTCP {
+		port := conf.Connection.Port
+		if port == 0 {
+			port = defaultTCPPort
+		}
+
+		return net.JoinHostPort(conf.Connection.Host, strconv.Itoa(port))
+	}
+
+	if conf.Connection.Type == ConnectHTTP || conf.Connection.Type == ConnectWebSocket {
+		schema, path := "http", "json-rpc"
+		if conf.Connection.Type == ConnectWebSocket {
+			schema, path = "ws", "" // WebSocket is served on the web server root
+		}
+
 		if conf.Connection.SSL {
-			schema = "https"
+			schema += "s"
 		}
 
 		host := conf.Connection.Host
@@ -185,7 +296,7 @@ func getURL(conf Config) string {
 			host = fmt.Sprintf("%s:%d", host, conf.Connection.Port)
 		}
 
-		return fmt.Sprintf("%s://%s/json-rpc", schema, host)
+		return fmt.Sprintf("%s://%s/%s", schema, host, path)
 	}
 
 	return ""
@@ -1,18 +1,26 @@
 package hyperion
 
 import (
+	"bufio"
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"slices"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/denwwer/hyperion-ng/model"
 
+	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -22,6 +30,10 @@ const imageB64 = `iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAIAAACQd1PeAAAABGdBTUEAALGPC/
 func TestMain(m *testing.M) {
 	s := testServer()
 	defer s.Close()
+	l := testTCPServer()
+	defer l.Close()
+	ws := testWSServer()
+	defer ws.Close()
 	code := m.Run()
 	os.Exit(code)
 }
@@ -43,6 +55,8 @@ func TestServerInfo(t *testing.T) {
 	assert.NotEmpty(t, resp.Effects.System())
 	assert.NotEmpty(t, resp.LedDevices.Available)
 	assert.Equal(t, "First LED Hardware instance", resp.Instances[0].Name)
+	assert.True(t, resp.Suspended)
+	assert.False(t, resp.Idle)
 }
 
 func TestSystemInfo(t *testing.T) {
@@ -181,7 +195,11 @@ func TestInstance(t *testing.T) {
 	require.Nil(t, err)
 }
 
-var testURL string
+var (
+	testURL     string
+	testTCPAddr string
+	testWSURL   string
+)
 
 func testServer() *httptest.Server {
 	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -192,29 +210,178 @@ func testServer() *httptest.Server {
 			log.Fatalln(err)
 		}
 
-		cmd := req["command"].(string)
-		if cmd == "sourceselect" && req["priority"] != nil && req["priority"].(float64) == -1 {
-			cmd = "sourceselecterror" // returns error for sourceselect
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(testResponse(req))
+	}))
+
+	testURL = s.URL
+	return s
+}
+
+// testTCPServer stand-in for Hyperion JSON server with new line delimited messages.
+func testTCPServer() net.Listener {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return // listener closed
+			}
+
+			go func() {
+				defer conn.Close()
+				scanner := bufio.NewScanner(conn)
+				scanner.Buffer(nil, 1<<20)
+				mu := sync.Mutex{}
+
+				for scanner.Scan() {
+					req := map[string]interface{}{}
+					if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+						log.Fatalln(err)
+					}
+
+					// process requests concurrently, so responses could come in any order
+					go func() {
+						resp := append(testSocketResponse(req), '\n')
+
+						mu.Lock()
+						defer mu.Unlock()
+						conn.Write(resp)
+
+						for _, event := range testEvents(req) {
+							conn.Write(append(event, '\n'))
+						}
+					}()
+				}
+			}()
 		}
+	}()
 
-		fb, err := os.ReadFile(fmt.Sprintf("testdata/%s.json", cmd))
+	testTCPAddr = l.Addr().String()
+	return l
+}
+
+// testWSServer stand-in for Hyperion WebSocket endpoint.
+func testWSServer() *httptest.Server {
+	upgrader := websocket.Upgrader{}
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
 		if err != nil {
-			if os.IsNotExist(err) {
-				fb, _ = os.ReadFile("testdata/success.json")
-			} else {
+			log.Fatalln(err)
+		}
+		defer conn.Close()
+
+		for {
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				return // connection closed
+			}
+
+			req := map[string]interface{}{}
+			if err := json.Unmarshal(msg, &req); err != nil {
 				log.Fatalln(err)
 			}
-		}
 
-		w.Header().Set("Content-Type", "application/json")
-		w.Write(fb)
+			conn.WriteMessage(websocket.TextMessage, testSocketResponse(req))
+
+			for _, event := range testEvents(req) {
+				conn.WriteMessage(websocket.TextMessage, event)
+			}
+		}
 	}))
 
-	testURL = s.URL
+	testWSURL = s.URL
 	return s
 }
 
-func testClient() *Client {
+// testResponse returns content of testdata file by command name,
+// file named by command and subcommand (and LED device type) is preferred.
+func testResponse(req map[string]interface{}) []byte {
+	cmd := req["command"].(string)
+	if cmd == "sourceselect" && req["priority"] != nil && req["priority"].(float64) == -1 {
+		cmd = "sourceselecterror" // returns error for sourceselect
+	}
+
+	if sub, ok := req["subcommand"].(string); ok {
+		// response specific to LED device type
+		if device, ok := req["ledDeviceType"].(string); ok {
+			if fb, err := os.ReadFile(fmt.Sprintf("testdata/%s_%s_%s.json", cmd, sub, device)); err == nil {
+				return fb
+			}
+		}
+
+		if fb, err := os.ReadFile(fmt.Sprintf("testdata/%s_%s.json", cmd, sub)); err == nil {
+			return fb
+		}
+	}
+
+	fb, err := os.ReadFile(fmt.Sprintf("testdata/%s.json", cmd))
+	if err != nil {
+		if os.IsNotExist(err) {
+			fb, _ = os.ReadFile("testdata/success.json")
+		} else {
+			log.Fatalln(err)
+		}
+	}
+
+	return fb
+}
+
+// testSocketResponse returns compact response with tan of request.
+func testSocketResponse(req map[string]interface{}) []byte {
+	resp := map[string]interface{}{}
+	if err := json.Unmarshal(testResponse(req), &resp); err != nil {
+		log.Fatalln(err)
+	}
+
+	resp["tan"] = req["tan"]
+
+	b, err := json.Marshal(resp)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	return b
+}
+
+// testEvents returns compact events pushed after subscription, events are stored in testdata by name.
+func testEvents(req map[string]interface{}) [][]byte {
+	subscribe, _ := req["subscribe"].([]interface{})
+	events := [][]byte{}
+
+	// streams started by command
+	if sub, ok := req["subcommand"].(string); ok {
+		switch {
+		case req["command"] == "ledcolors" && strings.HasSuffix(sub, "-start"):
+			subscribe = append(subscribe, "ledcolors-"+strings.TrimSuffix(sub, "-start")+"-update")
+		case req["command"] == "logging" && sub == "start":
+			subscribe = append(subscribe, "logmsg-update")
+		}
+	}
+
+	for _, name := range subscribe {
+		fb, err := os.ReadFile(fmt.Sprintf("testdata/%s.json", name))
+		if err != nil {
+			continue
+		}
+
+		buf := bytes.Buffer{}
+		if err = json.Compact(&buf, fb); err != nil {
+			log.Fatalln(err)
+		}
+
+		events = append(events, buf.Bytes())
+	}
+
+	return events
+}
+
+func testClient(opt ...ClientOption) *Client {
 	u, _ := url.Parse(testURL)
 	host := u.Hostname()
 	port, _ := strconv.Atoi(u.Port())
@@ -231,5 +398,75 @@ func testClient() *Client {
 			Port:  port,
 			Token: "6c224a4c-6ebf-491a-9d70-fb7681ca2a59",
 		},
+	}, opt...)
+}
+
+// testRequests recorded by client of testMemClient.
+type testRequests struct {
+	mu   sync.Mutex
+	list []map[string]interface{}
+}
+
+// all returns copy of recorded requests.
+func (r *testRequests) all() []map[string]interface{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.list)
+}
+
+// testMemClient creates client with in-memory transport which records requests and responds with testdata,
+// respond could replace response and is called one request at time, nil means testdata response.
+func testMemClient(respond func(req map[string]interface{}) []byte, opt ...ClientOption) (*Client, *testRequests) {
+	requests := &testRequests{}
+
+	c := NewClient(Config{}, append(opt, WithTransport(TransportFunc(func(ctx context.Context, req []byte) ([]byte, error) {
+		data := map[string]interface{}{}
+		if err := json.Unmarshal(req, &data); err != nil {
+			return nil, err
+		}
+
+		requests.mu.Lock()
+		defer requests.mu.Unlock()
+
+		requests.list = append(requests.list, data)
+
+		if respond != nil {
+			if resp := respond(data); resp != nil {
+				return resp, nil
+			}
+		}
+
+		return testResponse(data), nil
+	})))...)
+
+	return c, requests
+}
+
+func testWSClient() *Client {
+	u, _ := url.Parse(testWSURL)
+	host := u.Hostname()
+	port, _ := strconv.Atoi(u.Port())
+
+	return NewClient(Config{
+		Connection: Connection{
+			Type:  ConnectWebSocket,
+			Host:  host,
+			Port:  port,
+			Token: "6c224a4c-6ebf-491a-9d70-fb7681ca2a59",
+		},
 	})
 }
+
+func testTCPClient(opt ...ClientOption) *Client {
+	host, p, _ := net.SplitHostPort(testTCPAddr)
+	port, _ := strconv.Atoi(p)
+
+	return NewClient(Config{
+		Connection: Connection{
+			Type:  ConnectTCP,
+			Host:  host,
+			Port:  port,
+			Token: "6c224a4c-6ebf-491a-9d70-fb7681ca2a59",
+		},
+	}, opt...)
+}
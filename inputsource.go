@@ -0,0 +1,77 @@
+package hyperion
+
+import (
+	"context"
+
+	m "github.com/denwwer/hyperion-ng/internal/model"
+	"github.com/denwwer/hyperion-ng/model"
+)
+
+const cmdInputSource = "inputsource"
+
+const (
+	inputSourceDiscover      = "discover"
+	inputSourceGetProperties = "getProperties"
+)
+
+// Input source errors.
+var (
+	ErrSourceTypeRequired error = validationError("source type is required")
+	ErrDeviceRequired     error = validationError("device is required")
+)
+
+type inputSourceRequest struct {
+	m.Request
+	Type   string `json:"sourceType"`
+	Params struct {
+		Device string `json:"device,omitempty"`
+	} `json:"params"`
+}
+
+// DiscoverInputSources of given type, e.g. model.InputSourceVideo.
+func (c Client) DiscoverInputSources(sourceType string) (*model.InputSourceDiscovery, error) {
+	return c.DiscoverInputSourcesContext(context.Background(), sourceType)
+}
+
+// DiscoverInputSourcesContext same as DiscoverInputSources with context.
+func (c Client) DiscoverInputSourcesContext(ctx context.Context, sourceType string) (*model.InputSourceDiscovery, error) {
+	if sourceType == "" {
+		return nil, ErrSourceTypeRequired
+	}
+
+	req := inputSourceRequest{
+		Request: m.Request{Command: cmdInputSource, Subcommand: inputSourceDiscover},
+		Type:    sourceType,
+	}
+
+	resp := &model.InputSourceDiscovery{}
+	return resp, c.send(ctx, req, &resp)
+}
+
+// InputSourceProperties retrieve inputs, formats and resolutions of device.
+func (c Client) InputSourceProperties(sourceType, device string) (*model.InputSource, error) {
+	return c.InputSourcePropertiesContext(context.Background(), sourceType, device)
+}
+
+// InputSourcePropertiesContext same as InputSourceProperties with context.
+func (c Client) InputSourcePropertiesContext(ctx context.Context, sourceType, device string) (*model.InputSource, error) {
+	if sourceType == "" {
+		return nil, ErrSourceTypeRequired
+	}
+
+	if device == "" {
+		return nil, ErrDeviceRequired
+	}
+
+	req := inputSourceRequest{
+		Request: m.Request{Command: cmdInputSource, Subcommand: inputSourceGetProperties},
+		Type:    sourceType,
+	}
+	req.Params.Device = device
+
+	resp := struct {
+		Properties *model.InputSource `json:"properties"`
+	}{Properties: &model.InputSource{}}
+
+	return resp.Properties, c.send(ctx, req, &resp)
+}
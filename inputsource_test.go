@@ -0,0 +1,70 @@
+package hyperion
+
+import (
+	"testing"
+
+	"github.com/denwwer/hyperion-ng/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscoverInputSources(t *testing.T) {
+	t.Parallel()
+	c, requests := testMemClient(nil)
+
+	found, err := c.DiscoverInputSources(model.InputSourceVideo)
+	require.Nil(t, err)
+	require.Len(t, found.Video, 1)
+
+	source := found.Video[0]
+	assert.Equal(t, "/dev/video0", source.Device)
+	assert.Equal(t, 640, source.Default.Input.Resolution.Width)
+
+	input := source.Inputs[0]
+	format := input.Formats[1]
+	res := format.Resolutions[0]
+	assert.Equal(t, "mjpeg", format.Format)
+	assert.Equal(t, []int{30, 25}, res.FPS)
+
+	grabber := source.V4L2Settings(input, format, res, res.FPS[0])
+	assert.Equal(t, "/dev/video0", *grabber.Device)
+	assert.Equal(t, "mjpeg", *grabber.Encoding)
+	assert.Equal(t, 1920, *grabber.Width)
+	assert.Equal(t, 30, *grabber.FPS)
+
+	_, err = c.DiscoverInputSources("")
+	assert.ErrorIs(t, err, ErrSourceTypeRequired)
+
+	assert.Equal(t, []map[string]interface{}{{
+		"command":    "inputsource",
+		"subcommand": "discover",
+		"sourceType": "video",
+		"params":     map[string]interface{}{},
+	}}, requests.all())
+}
+
+func TestInputSourceProperties(t *testing.T) {
+	t.Parallel()
+	c, requests := testMemClient(nil)
+
+	source, err := c.InputSourceProperties(model.InputSourceScreen, "qt")
+	require.Nil(t, err)
+	assert.Equal(t, "screen", source.Type)
+	require.Len(t, source.Inputs, 1)
+
+	input := source.Inputs[0]
+	grabber := source.FrameGrabberSettings(input, input.Formats[0].Resolutions[0], 25)
+	assert.Equal(t, "qt", *grabber.Device)
+	assert.Equal(t, 1080, *grabber.Height)
+
+	_, err = c.InputSourceProperties(model.InputSourceScreen, "")
+	assert.ErrorIs(t, err, ErrDeviceRequired)
+
+	assert.Equal(t, []map[string]interface{}{{
+		"command":    "inputsource",
+		"subcommand": "getProperties",
+		"sourceType": "screen",
+		"params":     map[string]interface{}{"device": "qt"},
+	}}, requests.all())
+}
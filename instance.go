@@ -0,0 +1,133 @@
+package hyperion
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	m "github.com/denwwer/hyperion-ng/internal/model"
+)
+
+const (
+	instanceCreate = "createInstance"
+	instanceDelete = "deleteInstance"
+	instanceRename = "saveName"
+)
+
+// Instance management errors.
+var (
+	ErrInstanceNameRequired error = validationError("instance name is required")
+	ErrInstanceNameExists   error = validationError("instance name already exists")
+	ErrInstanceNotFound     error = validationError("instance is not found")
+	ErrInstanceDefault      error = validationError("instance 0 could not be deleted")
+)
+
+type instanceRequest struct {
+	m.Request
+	Instance *int   `json:"instance,omitempty"`
+	Name     string `json:"name,omitempty"`
+}
+
+// CreateInstance with unique name and returns its id, new instance is stopped.
+func (c Client) CreateInstance(name string) (int, error) {
+	return c.CreateInstanceContext(context.Background(), name)
+}
+
+// CreateInstanceContext same as CreateInstance with context.
+func (c Client) CreateInstanceContext(ctx context.Context, name string) (int, error) {
+	if strings.TrimSpace(name) == "" {
+		return 0, ErrInstanceNameRequired
+	}
+
+	info, err := c.ServerInfoContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if info.Instances.FindName(name) != nil {
+		return 0, fmt.Errorf("%w: %s", ErrInstanceNameExists, name)
+	}
+
+	req := instanceRequest{
+		Request: m.Request{Command: cmdInstance, Subcommand: instanceCreate},
+		Name:    name,
+	}
+
+	if err = c.send(ctx, req, nil); err != nil {
+		return 0, err
+	}
+
+	// Hyperion does not respond with id
+	info, err = c.ServerInfoContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	instance := info.Instances.FindName(name)
+	if instance == nil {
+		return 0, fmt.Errorf("%w: %s", ErrInstanceNotFound, name)
+	}
+
+	return instance.Instance, nil
+}
+
+// DeleteInstance by id, default instance 0 could not be deleted.
+func (c Client) DeleteInstance(instance int) error {
+	return c.DeleteInstanceContext(context.Background(), instance)
+}
+
+// DeleteInstanceContext same as DeleteInstance with context.
+func (c Client) DeleteInstanceContext(ctx context.Context, instance int) error {
+	if instance == 0 {
+		return ErrInstanceDefault
+	}
+
+	info, err := c.ServerInfoContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if info.Instances.Find(instance) == nil {
+		return fmt.Errorf("%w: %d", ErrInstanceNotFound, instance)
+	}
+
+	req := instanceRequest{
+		Request:  m.Request{Command: cmdInstance, Subcommand: instanceDelete},
+		Instance: &instance,
+	}
+
+	return c.send(ctx, req, nil)
+}
+
+// RenameInstance by id, name should be unique.
+func (c Client) RenameInstance(instance int, name string) error {
+	return c.RenameInstanceContext(context.Background(), instance, name)
+}
+
+// RenameInstanceContext same as RenameInstance with context.
+func (c Client) RenameInstanceContext(ctx context.Context, instance int, name string) error {
+	if strings.TrimSpace(name) == "" {
+		return ErrInstanceNameRequired
+	}
+
+	info, err := c.ServerInfoContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if info.Instances.Find(instance) == nil {
+		return fmt.Errorf("%w: %d", ErrInstanceNotFound, instance)
+	}
+
+	if other := info.Instances.FindName(name); other != nil && other.Instance != instance {
+		return fmt.Errorf("%w: %s", ErrInstanceNameExists, name)
+	}
+
+	req := instanceRequest{
+		Request:  m.Request{Command: cmdInstance, Subcommand: instanceRename},
+		Instance: &instance,
+		Name:     name,
+	}
+
+	return c.send(ctx, req, nil)
+}
@@ -0,0 +1,56 @@
+package hyperion
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/denwwer/hyperion-ng/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstanceLifecycle(t *testing.T) {
+	t.Parallel()
+
+	instances := model.Instances{{Instance: 0, Name: "First LED Hardware instance", Running: true}}
+	c, _ := testMemClient(func(data map[string]interface{}) []byte {
+		switch data["subcommand"] {
+		case "createInstance":
+			instances = append(instances, model.Instance{Instance: len(instances), Name: data["name"].(string)})
+		case "saveName":
+			instances[int(data["instance"].(float64))].Name = data["name"].(string)
+		case "deleteInstance":
+			instances = instances[:int(data["instance"].(float64))]
+		}
+
+		if data["command"] != "serverinfo" {
+			return nil
+		}
+
+		info := map[string]interface{}{}
+		require.Nil(t, json.Unmarshal(testResponse(data), &info))
+		info["info"].(map[string]interface{})["instance"] = instances
+		resp, _ := json.Marshal(info)
+		return resp
+	})
+
+	id, err := c.CreateInstance("Living room")
+	require.Nil(t, err)
+	assert.Equal(t, 1, id)
+
+	_, err = c.CreateInstance("living room")
+	assert.ErrorIs(t, err, ErrInstanceNameExists)
+
+	assert.ErrorIs(t, c.RenameInstance(id, "First LED Hardware instance"), ErrInstanceNameExists)
+	require.Nil(t, c.RenameInstance(id, "Kitchen"))
+	assert.Equal(t, "Kitchen", instances[1].Name)
+
+	assert.ErrorIs(t, c.DeleteInstance(0), ErrInstanceDefault)
+	assert.ErrorIs(t, c.DeleteInstance(5), ErrInstanceNotFound)
+	require.Nil(t, c.DeleteInstance(id))
+	assert.Len(t, instances, 1)
+
+	_, err = c.CreateInstance(" ")
+	assert.ErrorIs(t, err, ErrValidation)
+}
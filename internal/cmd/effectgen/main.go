@@ -0,0 +1,145 @@
+// Command effectgen generates typed arguments of effects from settings schema of Hyperion.
+//
+//	effectgen -in effectschemas.json -out effectargs.go
+//
+// Input is "info" of config getschema response, see Client.GetSchema.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/denwwer/hyperion-ng/model"
+)
+
+func main() {
+	in := flag.String("in", "effectschemas.json", "settings schema")
+	out := flag.String("out", "effectargs.go", "generated file")
+	flag.Parse()
+
+	b, err := os.ReadFile(*in)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	schemas := model.EffectSchemas{}
+	if err = json.Unmarshal(b, &schemas); err != nil {
+		log.Fatalln(err)
+	}
+
+	src, err := format.Source(generate(schemas))
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	if err = os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatalln(err)
+	}
+}
+
+func generate(schemas model.EffectSchemas) []byte {
+	scripts := make([]string, 0, len(schemas))
+	for script := range schemas {
+		scripts = append(scripts, script)
+	}
+	sort.Strings(scripts)
+
+	buf := bytes.Buffer{}
+	buf.WriteString("// Code generated by effectgen; DO NOT EDIT.\n\npackage model\n")
+
+	for _, script := range scripts {
+		schema := schemas[script]
+		name := goName(strings.TrimSuffix(script, ".py")) + "Args"
+
+		fmt.Fprintf(&buf, "\n// %s are arguments of %s effect, nil fields are defaulted by Hyperion.\n", name, script)
+		fmt.Fprintf(&buf, "type %s struct {\n", name)
+
+		for _, p := range schema.Params {
+			fmt.Fprintf(&buf, "\t%s %s `json:\"%s,omitempty\"`", goName(p.Name), goType(p, true), p.Name)
+			if c := constraints(p); c != "" {
+				fmt.Fprintf(&buf, " // %s", c)
+			}
+			buf.WriteString("\n")
+		}
+
+		buf.WriteString("}\n")
+		fmt.Fprintf(&buf, "\n// Script of effect.\nfunc (%s) Script() string {\n\treturn %q\n}\n", name, script)
+		fmt.Fprintf(&buf, "\n// Args used by Effect.\nfunc (a %s) Args() map[string]interface{} {\n\treturn toArgs(a)\n}\n", name)
+	}
+
+	return buf.Bytes()
+}
+
+// goName converts argument name like "color-end_time" to "ColorEndTime".
+func goName(name string) string {
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return r == '-' || r == '_' || r == ' '
+	})
+
+	for i, part := range parts {
+		r := []rune(part)
+		r[0] = unicode.ToUpper(r[0])
+		parts[i] = string(r)
+	}
+
+	return strings.Join(parts, "")
+}
+
+func goType(p model.EffectParam, pointer bool) string {
+	var t string
+
+	switch p.Type {
+	case "integer":
+		t = "int"
+	case "number":
+		t = "float64"
+	case "boolean":
+		t = "bool"
+	case "string":
+		t = "string"
+	case "array":
+		if p.Items == nil {
+			return "[]interface{}"
+		}
+		return "[]" + goType(*p.Items, false)
+	default:
+		return "interface{}"
+	}
+
+	if pointer {
+		return "*" + t
+	}
+
+	return t
+}
+
+func constraints(p model.EffectParam) string {
+	var c []string
+
+	if p.Minimum != nil {
+		c = append(c, fmt.Sprintf(">= %v", *p.Minimum))
+	}
+
+	if p.Maximum != nil {
+		c = append(c, fmt.Sprintf("<= %v", *p.Maximum))
+	}
+
+	if len(p.Enum) > 0 {
+		c = append(c, fmt.Sprintf("one of %v", p.Enum))
+	}
+
+	if p.Default != nil {
+		d, _ := json.Marshal(p.Default)
+		c = append(c, "default "+string(d))
+	}
+
+	return strings.Join(c, ", ")
+}
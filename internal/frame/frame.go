@@ -0,0 +1,29 @@
+// Package frame implements size prefixed messages used by Hyperion FlatBuffers and Protobuf servers.
+package frame
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// Write message with 4 bytes big endian size header.
+func Write(w io.Writer, msg []byte) error {
+	frame := binary.BigEndian.AppendUint32(make([]byte, 0, len(msg)+4), uint32(len(msg)))
+	_, err := w.Write(append(frame, msg...))
+	return err
+}
+
+// Read single message with 4 bytes big endian size header.
+func Read(r io.Reader) ([]byte, error) {
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, binary.BigEndian.Uint32(header))
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
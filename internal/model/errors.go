@@ -1,13 +1,4 @@
 package model
 
-// Validation errors.
-const (
-	TokenRequire     = "Token is required"
-	ColorRequired    = "color is required"
-	PriorityRequired = "priority is required"
-	OriginRequired   = "origin is required"
-	DurationRequired = "duration shuld be >= 0"
-)
-
 // AuthError on authorization validation.
 const AuthError = "no authorization"
@@ -0,0 +1,28 @@
+// Package rgb converts colors and images into raw RGB form used by Hyperion servers.
+package rgb
+
+import (
+	"image"
+	"image/color"
+)
+
+// Pack color into 0xRRGGBB integer.
+func Pack(c color.Color) int32 {
+	p := color.RGBAModel.Convert(c).(color.RGBA)
+	return int32(p.R)<<16 | int32(p.G)<<8 | int32(p.B)
+}
+
+// FromImage returns 3 bytes per pixel, row by row.
+func FromImage(img image.Image) []byte {
+	bounds := img.Bounds()
+	data := make([]byte, 0, bounds.Dx()*bounds.Dy()*3)
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			p := color.RGBAModel.Convert(img.At(x, y)).(color.RGBA)
+			data = append(data, p.R, p.G, p.B)
+		}
+	}
+
+	return data
+}
@@ -0,0 +1,117 @@
+package hyperion
+
+import (
+	"context"
+	"encoding/json"
+	"image"
+	"image/color"
+	"sync"
+	"time"
+
+	m "github.com/denwwer/hyperion-ng/internal/model"
+	"github.com/denwwer/hyperion-ng/model"
+)
+
+const cmdLedColors = "ledcolors"
+
+const (
+	ledStreamStart   = "ledstream-start"
+	ledStreamStop    = "ledstream-stop"
+	imageStreamStart = "imagestream-start"
+	imageStreamStop  = "imagestream-stop"
+)
+
+// streamStopTimeout to stop stream on server after its context is done.
+const streamStopTimeout = time.Second
+
+// keepLocked marks context of request sent while socketTransport.keepMu is held.
+type keepLocked struct{}
+
+// LedStream streams current colors of LEDs aligned with Information.Leds.
+// Stream is stopped and channel is closed when ctx is done,
+// frames are skipped while receiver is busy, so it always gets the latest one.
+func (c *Client) LedStream(ctx context.Context) (<-chan []color.RGBA, error) {
+	return stream(ctx, c, model.EventLedColors, cmdLedColors, ledStreamStart, ledStreamStop, 1, func(event model.Event) [][]color.RGBA {
+		return [][]color.RGBA{event.LedColors}
+	})
+}
+
+// ImageStream streams current image of Hyperion, works the same way as LedStream.
+func (c *Client) ImageStream(ctx context.Context) (<-chan image.Image, error) {
+	return stream(ctx, c, model.EventLedImage, cmdLedColors, imageStreamStart, imageStreamStop, 1, func(event model.Event) []image.Image {
+		return []image.Image{event.Image}
+	})
+}
+
+// stream starts stream by command and delivers values of its events until ctx is done,
+// new values are dropped when channel of given size is full, channel of size 1 keeps the latest value.
+func stream[T any](ctx context.Context, c *Client, event model.EventType, command, start, stop string, size int, values func(event model.Event) []T) (<-chan T, error) {
+	socket, ok := c.transport.(*socketTransport)
+	if !ok {
+		return nil, ErrSubscriptionNotAllowed
+	}
+
+	ch := make(chan T, size)
+	mu := sync.Mutex{}
+	closed := false
+
+	id := c.events.add(func(event model.Event) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if closed {
+			return
+		}
+
+		for _, v := range values(event) {
+			if size == 1 {
+				select {
+				case <-ch: // replace stale value
+				default:
+				}
+			}
+
+			select {
+			case ch <- v:
+			default: // receiver is busy
+			}
+		}
+	}, []model.EventType{event})
+
+	// stream is shared by consumers, it is started by the first one and stopped by the last one
+	req, _ := json.Marshal(m.Request{Command: command, Subcommand: start})
+	req = c.bind(command, req)
+
+	socket.keepMu.Lock()
+	if !socket.kept(req) {
+		if err := c.send(context.WithValue(ctx, keepLocked{}, true), json.RawMessage(req), nil); err != nil {
+			socket.keepMu.Unlock()
+			c.events.remove(id)
+			return nil, err
+		}
+	}
+	socket.keep(req)
+	socket.keepMu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		c.events.remove(id)
+
+		socket.keepMu.Lock()
+		if socket.forget(req) {
+			stopCtx, cancel := context.WithTimeout(context.WithValue(context.Background(), keepLocked{}, true), streamStopTimeout)
+			if err := c.send(stopCtx, m.Request{Command: command, Subcommand: stop}, nil); err != nil {
+				c.logger.Warn("could not stop " + start + ": " + err.Error())
+			}
+			cancel()
+		}
+		socket.keepMu.Unlock()
+
+		mu.Lock()
+		closed = true
+		close(ch)
+		mu.Unlock()
+	}()
+
+	return ch, nil
+}
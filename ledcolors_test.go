@@ -0,0 +1,147 @@
+package hyperion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"sync"
+	"testing"
+	"time"
+
+	m "github.com/denwwer/hyperion-ng/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLedStream(t *testing.T) {
+	t.Parallel()
+	c := testWSClient()
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	leds, err := c.LedStream(ctx)
+	require.Nil(t, err)
+
+	assert.Equal(t, []color.RGBA{{R: 255, A: 255}, {G: 255, A: 255}, {B: 255, A: 255}}, <-leds)
+
+	cancel()
+	_, ok := <-leds
+	assert.False(t, ok)
+}
+
+func TestLedStreamLatest(t *testing.T) {
+	t.Parallel()
+	c := testTCPClient()
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	leds, err := c.LedStream(ctx)
+	require.Nil(t, err)
+	<-leds // pushed by server on start
+
+	// receiver is busy while frames come
+	for red := 1; red <= 3; red++ {
+		c.events.dispatch([]byte(fmt.Sprintf(`{"command":"ledcolors-ledstream-update","result":{"leds":[%d,0,0]}}`, red)))
+	}
+
+	assert.Equal(t, []color.RGBA{{R: 3, A: 255}}, <-leds)
+}
+
+func TestLedStreamShared(t *testing.T) {
+	t.Parallel()
+	c := testTCPClient()
+	defer c.Close()
+
+	conn := &testRecordConn{}
+	socket := c.transport.(*socketTransport)
+	dial := socket.dial
+	socket.dial = func(timeout time.Duration) (frameConn, error) {
+		frame, err := dial(timeout)
+		conn.frameConn = frame
+		return conn, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	firstCtx, cancelFirst := context.WithCancel(ctx)
+	first, err := c.LedStream(firstCtx)
+	require.Nil(t, err)
+
+	second, err := c.LedStream(ctx)
+	require.Nil(t, err)
+	<-first
+
+	start, _ := json.Marshal(m.Request{Command: cmdLedColors, Subcommand: ledStreamStart})
+
+	// the other stream keeps going
+	cancelFirst()
+	_, ok := <-first
+	assert.False(t, ok)
+	assert.True(t, socket.kept(start))
+	assert.Equal(t, []string{ledStreamStart}, conn.subcommands(cmdLedColors))
+
+	cancel()
+	_, ok = <-second
+	assert.False(t, ok)
+	assert.False(t, socket.kept(start))
+	assert.Equal(t, []string{ledStreamStart, ledStreamStop}, conn.subcommands(cmdLedColors))
+}
+
+func TestImageStream(t *testing.T) {
+	t.Parallel()
+	c := testTCPClient()
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	images, err := c.ImageStream(ctx)
+	require.Nil(t, err)
+
+	img := <-images
+	require.NotNil(t, img)
+	assert.Equal(t, 1, img.Bounds().Dx())
+
+	_, err = testClient().ImageStream(ctx)
+	assert.ErrorIs(t, err, ErrSubscriptionNotAllowed)
+}
+
+// testRecordConn records requests written to connection.
+type testRecordConn struct {
+	frameConn
+	mu       sync.Mutex
+	requests []m.Request
+}
+
+func (r *testRecordConn) writeMessage(msg []byte) error {
+	req := m.Request{}
+	_ = json.Unmarshal(msg, &req)
+
+	r.mu.Lock()
+	r.requests = append(r.requests, req)
+	r.mu.Unlock()
+
+	return r.frameConn.writeMessage(msg)
+}
+
+// subcommands of written requests with given command.
+func (r *testRecordConn) subcommands(command string) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var subcommands []string
+	for _, req := range r.requests {
+		if req.Command == command {
+			subcommands = append(subcommands, req.Subcommand)
+		}
+	}
+
+	return subcommands
+}
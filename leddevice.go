@@ -0,0 +1,83 @@
+package hyperion
+
+import (
+	"context"
+
+	m "github.com/denwwer/hyperion-ng/internal/model"
+	"github.com/denwwer/hyperion-ng/model"
+)
+
+const cmdLedDevice = "leddevice"
+
+const (
+	ledDeviceDiscover         = "discover"
+	ledDeviceGetProperties    = "getProperties"
+	ledDeviceIdentify         = "identify"
+	ledDeviceAddAuthorization = "addAuthorization"
+)
+
+// ErrLedDeviceTypeRequired is returned when LED device type is empty.
+var ErrLedDeviceTypeRequired error = validationError("LED device type is required")
+
+type ledDeviceRequest struct {
+	m.Request
+	Type   string                `json:"ledDeviceType"`
+	Params model.LedDeviceParams `json:"params"`
+}
+
+// DiscoverLedDevices of given type, e.g. model.LedDeviceWLED.
+func (c Client) DiscoverLedDevices(deviceType string, params model.LedDeviceParams) (*model.LedDeviceDiscovery, error) {
+	return c.DiscoverLedDevicesContext(context.Background(), deviceType, params)
+}
+
+// DiscoverLedDevicesContext same as DiscoverLedDevices with context.
+func (c Client) DiscoverLedDevicesContext(ctx context.Context, deviceType string, params model.LedDeviceParams) (*model.LedDeviceDiscovery, error) {
+	resp := &model.LedDeviceDiscovery{}
+	return resp, c.ledDevice(ctx, ledDeviceDiscover, deviceType, params, &resp)
+}
+
+// LedDeviceProperties retrieve properties of device.
+func (c Client) LedDeviceProperties(deviceType string, params model.LedDeviceParams) (*model.LedDeviceProperties, error) {
+	return c.LedDevicePropertiesContext(context.Background(), deviceType, params)
+}
+
+// LedDevicePropertiesContext same as LedDeviceProperties with context.
+func (c Client) LedDevicePropertiesContext(ctx context.Context, deviceType string, params model.LedDeviceParams) (*model.LedDeviceProperties, error) {
+	resp := &model.LedDeviceProperties{}
+	return resp, c.ledDevice(ctx, ledDeviceGetProperties, deviceType, params, &resp)
+}
+
+// IdentifyLedDevice by blinking its LEDs.
+func (c Client) IdentifyLedDevice(deviceType string, params model.LedDeviceParams) error {
+	return c.IdentifyLedDeviceContext(context.Background(), deviceType, params)
+}
+
+// IdentifyLedDeviceContext same as IdentifyLedDevice with context.
+func (c Client) IdentifyLedDeviceContext(ctx context.Context, deviceType string, params model.LedDeviceParams) error {
+	return c.ledDevice(ctx, ledDeviceIdentify, deviceType, params, nil)
+}
+
+// AuthorizeLedDevice creates credentials on device, e.g. press link button of Philips Hue bridge before.
+func (c Client) AuthorizeLedDevice(deviceType string, params model.LedDeviceParams) (*model.LedDeviceAuth, error) {
+	return c.AuthorizeLedDeviceContext(context.Background(), deviceType, params)
+}
+
+// AuthorizeLedDeviceContext same as AuthorizeLedDevice with context.
+func (c Client) AuthorizeLedDeviceContext(ctx context.Context, deviceType string, params model.LedDeviceParams) (*model.LedDeviceAuth, error) {
+	resp := &model.LedDeviceAuth{}
+	return resp, c.ledDevice(ctx, ledDeviceAddAuthorization, deviceType, params, &resp)
+}
+
+func (c Client) ledDevice(ctx context.Context, subcommand, deviceType string, params model.LedDeviceParams, respInfo interface{}) error {
+	if deviceType == "" {
+		return ErrLedDeviceTypeRequired
+	}
+
+	req := ledDeviceRequest{
+		Request: m.Request{Command: cmdLedDevice, Subcommand: subcommand},
+		Type:    deviceType,
+		Params:  params,
+	}
+
+	return c.send(ctx, req, respInfo)
+}
@@ -0,0 +1,92 @@
+package hyperion
+
+import (
+	"testing"
+
+	"github.com/denwwer/hyperion-ng/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscoverLedDevices(t *testing.T) {
+	t.Parallel()
+	c := testClient()
+
+	found, err := c.DiscoverLedDevices(model.LedDeviceWLED, model.LedDeviceParams{})
+	require.Nil(t, err)
+	assert.Equal(t, "mDNS", found.Method)
+	require.Len(t, found.Devices, 1)
+	assert.Equal(t, "WLED-Desk", found.Devices[0].Name)
+	assert.Equal(t, "192.168.53.140", found.Devices[0].IP)
+	assert.Equal(t, 80, found.Devices[0].Port)
+
+	found, err = c.DiscoverLedDevices("adalight", model.LedDeviceParams{})
+	require.Nil(t, err)
+	assert.Equal(t, "serial", found.Method)
+	assert.Equal(t, "/dev/ttyUSB0", found.Devices[0].SystemLocation)
+	assert.Equal(t, "0x67b", found.Devices[0].VendorID)
+
+	_, err = c.DiscoverLedDevices("", model.LedDeviceParams{})
+	assert.ErrorIs(t, err, ErrLedDeviceTypeRequired)
+}
+
+func TestLedDeviceProperties(t *testing.T) {
+	t.Parallel()
+	c := testClient()
+
+	props, err := c.LedDeviceProperties(model.LedDeviceWLED, model.LedDeviceParams{Host: "192.168.53.140"})
+	require.Nil(t, err)
+	wled, err := props.WLED()
+	require.Nil(t, err)
+	assert.Equal(t, "0.14.4", wled.Info.Version)
+	assert.Equal(t, 120, wled.Info.Leds.Count)
+	assert.True(t, wled.State.On)
+
+	props, err = c.LedDeviceProperties(model.LedDeviceHue, model.LedDeviceParams{Host: "192.168.53.141", User: "9Vwqwo0Q"})
+	require.Nil(t, err)
+	hue, err := props.Hue()
+	require.Nil(t, err)
+	assert.Equal(t, "BSB002", hue.ModelID)
+	assert.Equal(t, "Entertainment", hue.Groups["200"].Type)
+	assert.Len(t, hue.Lights, 2)
+
+	props, err = c.LedDeviceProperties(model.LedDeviceNanoleaf, model.LedDeviceParams{Host: "192.168.53.142", Token: "x"})
+	require.Nil(t, err)
+	leaf, err := props.Nanoleaf()
+	require.Nil(t, err)
+	assert.Equal(t, 2, leaf.PanelLayout.Layout.NumPanels)
+	assert.Equal(t, 2912, leaf.PanelLayout.Layout.PositionData[1].PanelID)
+
+	props, err = c.LedDeviceProperties(model.LedDeviceYeelight, model.LedDeviceParams{Host: "192.168.53.143", Port: 55443})
+	require.Nil(t, err)
+	yeelight, err := props.Yeelight()
+	require.Nil(t, err)
+	assert.Equal(t, "color4", yeelight.Model)
+	assert.Equal(t, "on", yeelight.Power)
+
+	props, err = c.LedDeviceProperties(model.LedDeviceCololight, model.LedDeviceParams{Host: "192.168.53.144"})
+	require.Nil(t, err)
+	cololight, err := props.Cololight()
+	require.Nil(t, err)
+	assert.Equal(t, "Plus", cololight.ModelType)
+	assert.Equal(t, 19, cololight.LedCount)
+
+	props, err = c.LedDeviceProperties(model.LedDeviceRazer, model.LedDeviceParams{})
+	require.Nil(t, err)
+	razer, err := props.Razer()
+	require.Nil(t, err)
+	assert.Equal(t, "3.30.03", razer.Version)
+}
+
+func TestIdentifyLedDevice(t *testing.T) {
+	t.Parallel()
+	c := testClient()
+
+	require.Nil(t, c.IdentifyLedDevice(model.LedDeviceHue, model.LedDeviceParams{Host: "192.168.53.141", User: "9Vwqwo0Q", LightIDs: []int{1}}))
+
+	auth, err := c.AuthorizeLedDevice(model.LedDeviceHue, model.LedDeviceParams{Host: "192.168.53.141"})
+	require.Nil(t, err)
+	assert.Equal(t, "9Vwqwo0QvdrDGdAPfGVk7Ry0KZXb1mRLbYdT6f5C", auth.User)
+	assert.NotEmpty(t, auth.ClientKey)
+}
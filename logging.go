@@ -0,0 +1,127 @@
+package hyperion
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sync"
+	"time"
+
+	m "github.com/denwwer/hyperion-ng/internal/model"
+	"github.com/denwwer/hyperion-ng/model"
+)
+
+const cmdLogging = "logging"
+
+const (
+	loggingStart = "start"
+	loggingStop  = "stop"
+)
+
+const (
+	logBuffer        = 256         // size of channel returned by Logs
+	errorLogsTimeout = time.Second // to fetch log for failed command
+)
+
+// WithErrorLogs attach last n entries of Hyperion log to APIError of failed command,
+// log is available over TCP or WebSocket connection only. Option is ignored when n <= 0.
+func WithErrorLogs(n int) ClientOption {
+	return func(c *Client) {
+		if n <= 0 {
+			c.errorLogs = nil
+			return
+		}
+
+		c.errorLogs = &logTail{size: n, updated: make(chan struct{}, 1)}
+	}
+}
+
+// Logs streams Hyperion log, entries kept by server come first.
+// Stream is stopped and channel is closed when ctx is done,
+// entries are dropped if receiver falls behind.
+func (c *Client) Logs(ctx context.Context) (<-chan model.LogEntry, error) {
+	return stream(ctx, c, model.EventLog, cmdLogging, loggingStart, loggingStop, logBuffer, func(event model.Event) []model.LogEntry {
+		return event.Logs
+	})
+}
+
+// attachLogs adds recent Hyperion log to error of failed command.
+func (c *Client) attachLogs(ctx context.Context, apiErr *APIError) {
+	if c.errorLogs == nil || apiErr.Command == cmdLogging {
+		return
+	}
+
+	socket, ok := c.transport.(*socketTransport)
+	if !ok {
+		return
+	}
+
+	start, _ := json.Marshal(m.Request{Command: cmdLogging, Subcommand: loggingStart})
+
+	// tail is shared and logging could be started by Logs meanwhile,
+	// lock is already held when command of stream is failed
+	if ctx.Value(keepLocked{}) == nil {
+		socket.keepMu.Lock()
+		defer socket.keepMu.Unlock()
+	}
+
+	// log is already streamed by Logs, otherwise ask server for kept entries
+	if !socket.kept(start) {
+		ctx, cancel := context.WithTimeout(context.Background(), errorLogsTimeout)
+		defer cancel()
+
+		c.errorLogs.reset()
+
+		if c.send(ctx, json.RawMessage(start), nil) == nil {
+			select {
+			case <-c.errorLogs.updated:
+			case <-ctx.Done():
+			}
+		}
+
+		if !socket.kept(start) {
+			_ = c.send(ctx, m.Request{Command: cmdLogging, Subcommand: loggingStop}, nil)
+		}
+	}
+
+	apiErr.Logs = c.errorLogs.last()
+}
+
+// logTail keeps last entries of Hyperion log.
+type logTail struct {
+	mu      sync.Mutex
+	size    int
+	entries []model.LogEntry
+	updated chan struct{}
+}
+
+func (l *logTail) add(event model.Event) {
+	l.mu.Lock()
+	l.entries = append(l.entries, event.Logs...)
+	if len(l.entries) > l.size {
+		l.entries = l.entries[len(l.entries)-l.size:]
+	}
+	l.mu.Unlock()
+
+	select {
+	case l.updated <- struct{}{}:
+	default:
+	}
+}
+
+func (l *logTail) reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries = nil
+	select {
+	case <-l.updated:
+	default:
+	}
+}
+
+func (l *logTail) last() []model.LogEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return slices.Clone(l.entries)
+}
@@ -0,0 +1,105 @@
+package hyperion
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogs(t *testing.T) {
+	t.Parallel()
+	c := testWSClient()
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	logs, err := c.Logs(ctx)
+	require.Nil(t, err)
+
+	entry := <-logs
+	assert.Equal(t, "INFO", entry.Level)
+	assert.Equal(t, "JSONSERVER", entry.Logger)
+	assert.Equal(t, "handleMessage", entry.Function)
+	assert.Equal(t, "New connection from 127.0.0.1", entry.Message)
+	assert.Equal(t, time.Date(2024, 7, 29, 19, 46, 40, 0, time.UTC), entry.Time().UTC())
+}
+
+func TestErrorLogs(t *testing.T) {
+	t.Parallel()
+	c := testTCPClient(WithErrorLogs(2))
+	defer c.Close()
+
+	err := c.SetSource(-1)
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Len(t, apiErr.Logs, 2)
+	assert.Equal(t, "[root].priority: value is too small (minimum=1)", apiErr.Logs[1].Message)
+
+	// concurrent failures get full log
+	wg := sync.WaitGroup{}
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var apiErr *APIError
+			if assert.ErrorAs(t, c.SetSource(-1), &apiErr) {
+				assert.Len(t, apiErr.Logs, 2)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// option is ignored
+	err = testTCPClient(WithErrorLogs(-1)).SetSource(-1)
+	require.ErrorAs(t, err, &apiErr)
+	assert.Empty(t, apiErr.Logs)
+
+	// log is not available over HTTP
+	err = testClient(WithErrorLogs(2)).SetSource(-1)
+	require.ErrorAs(t, err, &apiErr)
+	assert.Empty(t, apiErr.Logs)
+}
+
+func TestErrorLogsWithLogs(t *testing.T) {
+	t.Parallel()
+	c := testTCPClient(WithErrorLogs(2))
+	defer c.Close()
+
+	conn := &testRecordConn{}
+	socket := c.transport.(*socketTransport)
+	dial := socket.dial
+	socket.dial = func(timeout time.Duration) (frameConn, error) {
+		frame, err := dial(timeout)
+		conn.frameConn = frame
+		return conn, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Error(t, c.SetSource(-1))
+		}()
+	}
+
+	logs, err := c.Logs(ctx)
+	require.Nil(t, err)
+	wg.Wait()
+
+	// log of failed commands does not stop stream
+	subcommands := conn.subcommands(cmdLogging)
+	assert.Equal(t, loggingStart, subcommands[len(subcommands)-1])
+	_, ok := <-logs
+	assert.True(t, ok)
+}
@@ -0,0 +1,9 @@
+package model
+
+// Token for API access.
+type Token struct {
+	ID      string `json:"id"`
+	Comment string `json:"comment"`
+	Token   string `json:"token,omitempty"`    // Provided only on creation
+	LastUse string `json:"last_use,omitempty"` // Date of last usage in ISO 8601
+}
@@ -0,0 +1,238 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Color errors.
+var (
+	ErrColorHex    = errors.New("color should be hex like #ff8000 or #f80")
+	ErrColorName   = errors.New("color name is unknown")
+	ErrColorRange  = errors.New("color component is out of range")
+	ErrColorKelvin = errors.New("color temperature should be in range 1000-40000 K")
+)
+
+// Color in RGB, it implements color.Color.
+type Color struct {
+	R, G, B uint8
+}
+
+// RGB color.
+func RGB(r, g, b uint8) Color {
+	return Color{R: r, G: g, B: b}
+}
+
+// Hex color like "#ff8000", "ff8000" or "#f80".
+func Hex(hex string) (Color, error) {
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+
+	if len(hex) != 6 {
+		return Color{}, fmt.Errorf("%w: %q", ErrColorHex, hex)
+	}
+
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return Color{}, fmt.Errorf("%w: %q", ErrColorHex, hex)
+	}
+
+	return Color{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v)}, nil
+}
+
+// Named CSS color like "orange", case is ignored.
+func Named(name string) (Color, error) {
+	c, ok := namedColors[strings.ToLower(name)]
+	if !ok {
+		return Color{}, fmt.Errorf("%w: %q", ErrColorName, name)
+	}
+	return c, nil
+}
+
+// HSL color, hue in degrees, saturation and lightness in range 0-1.
+func HSL(h, s, l float64) (Color, error) {
+	if s < 0 || s > 1 || l < 0 || l > 1 {
+		return Color{}, fmt.Errorf("%w: saturation and lightness should be in range 0-1", ErrColorRange)
+	}
+
+	c := (1 - math.Abs(2*l-1)) * s
+	return hueColor(h, c, l-c/2), nil
+}
+
+// HSV color, hue in degrees, saturation and value in range 0-1.
+func HSV(h, s, v float64) (Color, error) {
+	if s < 0 || s > 1 || v < 0 || v > 1 {
+		return Color{}, fmt.Errorf("%w: saturation and value should be in range 0-1", ErrColorRange)
+	}
+
+	c := v * s
+	return hueColor(h, c, v-c), nil
+}
+
+// hueColor by hue, chroma and match value.
+func hueColor(h, c, m float64) Color {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+
+	x := c * (1 - math.Abs(math.Mod(h/60, 2)-1))
+
+	var r, g, b float64
+	switch {
+	case h < 60:
+		r, g, b = c, x, 0
+	case h < 120:
+		r, g, b = x, c, 0
+	case h < 180:
+		r, g, b = 0, c, x
+	case h < 240:
+		r, g, b = 0, x, c
+	case h < 300:
+		r, g, b = x, 0, c
+	default:
+		r, g, b = c, 0, x
+	}
+
+	return Color{R: channel(255 * (r + m)), G: channel(255 * (g + m)), B: channel(255 * (b + m))}
+}
+
+// Kelvin color of white light by temperature, e.g. 2700 is warm white.
+func Kelvin(k float64) (Color, error) {
+	if k < 1000 || k > 40000 {
+		return Color{}, fmt.Errorf("%w: %v", ErrColorKelvin, k)
+	}
+
+	// approximation by Tanner Helland
+	t := k / 100
+	var r, g, b float64
+
+	if t <= 66 {
+		r = 255
+		g = 99.4708025861*math.Log(t) - 161.1195681661
+	} else {
+		r = 329.698727446 * math.Pow(t-60, -0.1332047592)
+		g = 288.1221695283 * math.Pow(t-60, -0.0755148492)
+	}
+
+	switch {
+	case t >= 66:
+		b = 255
+	case t <= 19:
+		b = 0
+	default:
+		b = 138.5177312231*math.Log(t-10) - 305.0447927307
+	}
+
+	return Color{R: channel(r), G: channel(g), B: channel(b)}, nil
+}
+
+// channel rounds and clamps value to 0-255.
+func channel(v float64) uint8 {
+	return uint8(math.Round(math.Max(0, math.Min(255, v))))
+}
+
+// Hex returns color like "#ff8000".
+func (c Color) Hex() string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// HSL returns hue in degrees, saturation and lightness in range 0-1.
+func (c Color) HSL() (h, s, l float64) {
+	r, g, b := float64(c.R)/255, float64(c.G)/255, float64(c.B)/255
+	max, min := math.Max(r, math.Max(g, b)), math.Min(r, math.Min(g, b))
+	l = (max + min) / 2
+
+	d := max - min
+	if d == 0 {
+		return 0, 0, l
+	}
+
+	s = d / (1 - math.Abs(2*l-1))
+
+	switch max {
+	case r:
+		h = 60 * math.Mod((g-b)/d, 6)
+	case g:
+		h = 60 * ((b-r)/d + 2)
+	default:
+		h = 60 * ((r-g)/d + 4)
+	}
+
+	if h < 0 {
+		h += 360
+	}
+
+	return h, s, l
+}
+
+// RGBA implements color.Color.
+func (c Color) RGBA() (r, g, b, a uint32) {
+	r, g, b = uint32(c.R), uint32(c.G), uint32(c.B)
+	return r | r<<8, g | g<<8, b | b<<8, 0xFFFF
+}
+
+// Ints returns [R, G, B].
+func (c Color) Ints() []int {
+	return []int{int(c.R), int(c.G), int(c.B)}
+}
+
+// MarshalJSON encodes color as [R, G, B].
+func (c Color) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.Ints())
+}
+
+// UnmarshalJSON decodes color from [R, G, B].
+func (c *Color) UnmarshalJSON(b []byte) error {
+	var rgb []int
+	if err := json.Unmarshal(b, &rgb); err != nil {
+		return err
+	}
+
+	color, err := ColorFromInts(rgb)
+	if err != nil {
+		return err
+	}
+
+	*c = color
+	return nil
+}
+
+// ColorFromInts converts [R, G, B] with values in range 0-255.
+func ColorFromInts(rgb []int) (Color, error) {
+	if len(rgb) != 3 {
+		return Color{}, fmt.Errorf("%w: color should have 3 components, got %d", ErrColorRange, len(rgb))
+	}
+
+	for _, v := range rgb {
+		if v < 0 || v > 255 {
+			return Color{}, fmt.Errorf("%w: %d", ErrColorRange, v)
+		}
+	}
+
+	return Color{R: uint8(rgb[0]), G: uint8(rgb[1]), B: uint8(rgb[2])}, nil
+}
+
+// Colors is pattern of colors repeated over LEDs.
+type Colors []Color
+
+// Ints returns [R, G, B, R, G, B ...].
+func (c Colors) Ints() []int {
+	ints := make([]int, 0, 3*len(c))
+	for _, color := range c {
+		ints = append(ints, color.Ints()...)
+	}
+	return ints
+}
+
+// Color of priority source if it is color.
+func (p Priority) Color() (Color, bool) {
+	c, err := ColorFromInts(p.Value.RGB)
+	return c, err == nil
+}
@@ -0,0 +1,508 @@
+// Code generated by effectgen; DO NOT EDIT.
+
+package model
+
+// CandleArgs are arguments of candle.py effect, nil fields are defaulted by Hyperion.
+type CandleArgs struct {
+	Color                    []int    `json:"color,omitempty"`                     // default [255,138,0]
+	SleepTime                *float64 `json:"sleepTime,omitempty"`                 // >= 0.01, <= 1, default 0.14
+	Brightness               *int     `json:"brightness,omitempty"`                // >= 1, <= 100, default 100
+	Candles                  *string  `json:"candles,omitempty"`                   // one of [all list], default "all"
+	Ledlist                  *string  `json:"ledlist,omitempty"`                   // default "1,11,21"
+	SmoothingCustomSettings  *bool    `json:"smoothing-custom-settings,omitempty"` // default false
+	SmoothingTimeMs          *int     `json:"smoothing-time_ms,omitempty"`         // >= 25, <= 600, default 200
+	SmoothingUpdateFrequency *float64 `json:"smoothing-updateFrequency,omitempty"` // >= 1, <= 100, default 25
+}
+
+// Script of effect.
+func (CandleArgs) Script() string {
+	return "candle.py"
+}
+
+// Args used by Effect.
+func (a CandleArgs) Args() map[string]interface{} {
+	return toArgs(a)
+}
+
+// CollisionArgs are arguments of collision.py effect, nil fields are defaulted by Hyperion.
+type CollisionArgs struct {
+	Speed                    *int     `json:"speed,omitempty"`                     // >= 1, default 100
+	TrailLength              *int     `json:"trailLength,omitempty"`               // >= 1, default 5
+	ExplodeRadius            *int     `json:"explodeRadius,omitempty"`             // >= 1, default 8
+	SmoothingCustomSettings  *bool    `json:"smoothing-custom-settings,omitempty"` // default false
+	SmoothingTimeMs          *int     `json:"smoothing-time_ms,omitempty"`         // >= 25, <= 600, default 200
+	SmoothingUpdateFrequency *float64 `json:"smoothing-updateFrequency,omitempty"` // >= 1, <= 100, default 25
+}
+
+// Script of effect.
+func (CollisionArgs) Script() string {
+	return "collision.py"
+}
+
+// Args used by Effect.
+func (a CollisionArgs) Args() map[string]interface{} {
+	return toArgs(a)
+}
+
+// FadeArgs are arguments of fade.py effect, nil fields are defaulted by Hyperion.
+type FadeArgs struct {
+	ColorStart               []int    `json:"color-start,omitempty"`               // default [255,174,11]
+	ColorStartTime           *int     `json:"color-start-time,omitempty"`          // >= 0, default 1000
+	FadeInTime               *int     `json:"fade-in-time,omitempty"`              // >= 0, default 2000
+	ColorEnd                 []int    `json:"color-end,omitempty"`                 // default [100,100,100]
+	ColorEndTime             *int     `json:"color-end-time,omitempty"`            // >= 0, default 1000
+	FadeOutTime              *int     `json:"fade-out-time,omitempty"`             // >= 0, default 2000
+	RepeatCount              *int     `json:"repeat-count,omitempty"`              // >= 0, default 0
+	MaintainEndColor         *bool    `json:"maintain-end-color,omitempty"`        // default true
+	SmoothingCustomSettings  *bool    `json:"smoothing-custom-settings,omitempty"` // default false
+	SmoothingTimeMs          *int     `json:"smoothing-time_ms,omitempty"`         // >= 25, <= 600, default 200
+	SmoothingUpdateFrequency *float64 `json:"smoothing-updateFrequency,omitempty"` // >= 1, <= 100, default 25
+}
+
+// Script of effect.
+func (FadeArgs) Script() string {
+	return "fade.py"
+}
+
+// Args used by Effect.
+func (a FadeArgs) Args() map[string]interface{} {
+	return toArgs(a)
+}
+
+// FlagArgs are arguments of flag.py effect, nil fields are defaulted by Hyperion.
+type FlagArgs struct {
+	Countries                []string `json:"countries,omitempty"`                 // default ["de"]
+	SwitchTime               *int     `json:"switch-time,omitempty"`               // >= 1, default 2
+	SmoothingCustomSettings  *bool    `json:"smoothing-custom-settings,omitempty"` // default false
+	SmoothingTimeMs          *int     `json:"smoothing-time_ms,omitempty"`         // >= 25, <= 600, default 200
+	SmoothingUpdateFrequency *float64 `json:"smoothing-updateFrequency,omitempty"` // >= 1, <= 100, default 25
+}
+
+// Script of effect.
+func (FlagArgs) Script() string {
+	return "flag.py"
+}
+
+// Args used by Effect.
+func (a FlagArgs) Args() map[string]interface{} {
+	return toArgs(a)
+}
+
+// GifArgs are arguments of gif.py effect, nil fields are defaulted by Hyperion.
+type GifArgs struct {
+	ImageSource              *string  `json:"imageSource,omitempty"`               // one of [file url], default "file"
+	File                     *string  `json:"file,omitempty"`                      // default ""
+	Url                      *string  `json:"url,omitempty"`                       // default ""
+	Fps                      *int     `json:"fps,omitempty"`                       // >= 1, <= 100, default 25
+	Reverse                  *bool    `json:"reverse,omitempty"`                   // default false
+	CropLeft                 *int     `json:"cropLeft,omitempty"`                  // >= 0, default 0
+	CropRight                *int     `json:"cropRight,omitempty"`                 // >= 0, default 0
+	CropTop                  *int     `json:"cropTop,omitempty"`                   // >= 0, default 0
+	CropBottom               *int     `json:"cropBottom,omitempty"`                // >= 0, default 0
+	Grayscale                *bool    `json:"grayscale,omitempty"`                 // default false
+	SmoothingCustomSettings  *bool    `json:"smoothing-custom-settings,omitempty"` // default false
+	SmoothingTimeMs          *int     `json:"smoothing-time_ms,omitempty"`         // >= 25, <= 600, default 200
+	SmoothingUpdateFrequency *float64 `json:"smoothing-updateFrequency,omitempty"` // >= 1, <= 100, default 25
+}
+
+// Script of effect.
+func (GifArgs) Script() string {
+	return "gif.py"
+}
+
+// Args used by Effect.
+func (a GifArgs) Args() map[string]interface{} {
+	return toArgs(a)
+}
+
+// KnightRiderArgs are arguments of knight-rider.py effect, nil fields are defaulted by Hyperion.
+type KnightRiderArgs struct {
+	Speed                    *float64 `json:"speed,omitempty"`                     // >= 0.1, <= 10, default 1
+	FadeFactor               *float64 `json:"fadeFactor,omitempty"`                // >= 0, <= 0.9, default 0.7
+	Color                    []int    `json:"color,omitempty"`                     // default [255,0,0]
+	SmoothingCustomSettings  *bool    `json:"smoothing-custom-settings,omitempty"` // default false
+	SmoothingTimeMs          *int     `json:"smoothing-time_ms,omitempty"`         // >= 25, <= 600, default 200
+	SmoothingUpdateFrequency *float64 `json:"smoothing-updateFrequency,omitempty"` // >= 1, <= 100, default 25
+}
+
+// Script of effect.
+func (KnightRiderArgs) Script() string {
+	return "knight-rider.py"
+}
+
+// Args used by Effect.
+func (a KnightRiderArgs) Args() map[string]interface{} {
+	return toArgs(a)
+}
+
+// LedtestSeqArgs are arguments of ledtest-seq.py effect, nil fields are defaulted by Hyperion.
+type LedtestSeqArgs struct {
+	SleepTime                *float64 `json:"sleepTime,omitempty"`                 // >= 0.01, default 0.5
+	SmoothingCustomSettings  *bool    `json:"smoothing-custom-settings,omitempty"` // default false
+	SmoothingTimeMs          *int     `json:"smoothing-time_ms,omitempty"`         // >= 25, <= 600, default 200
+	SmoothingUpdateFrequency *float64 `json:"smoothing-updateFrequency,omitempty"` // >= 1, <= 100, default 25
+}
+
+// Script of effect.
+func (LedtestSeqArgs) Script() string {
+	return "ledtest-seq.py"
+}
+
+// Args used by Effect.
+func (a LedtestSeqArgs) Args() map[string]interface{} {
+	return toArgs(a)
+}
+
+// LedtestArgs are arguments of ledtest.py effect, nil fields are defaulted by Hyperion.
+type LedtestArgs struct {
+	SleepTime                *float64 `json:"sleepTime,omitempty"`                 // >= 0.01, default 0.5
+	Testleds                 *string  `json:"testleds,omitempty"`                  // one of [all list], default "all"
+	Ledlist                  *string  `json:"ledlist,omitempty"`                   // default "0"
+	SmoothingCustomSettings  *bool    `json:"smoothing-custom-settings,omitempty"` // default false
+	SmoothingTimeMs          *int     `json:"smoothing-time_ms,omitempty"`         // >= 25, <= 600, default 200
+	SmoothingUpdateFrequency *float64 `json:"smoothing-updateFrequency,omitempty"` // >= 1, <= 100, default 25
+}
+
+// Script of effect.
+func (LedtestArgs) Script() string {
+	return "ledtest.py"
+}
+
+// Args used by Effect.
+func (a LedtestArgs) Args() map[string]interface{} {
+	return toArgs(a)
+}
+
+// LightClockArgs are arguments of light-clock.py effect, nil fields are defaulted by Hyperion.
+type LightClockArgs struct {
+	ShowSeconds              *bool    `json:"show_seconds,omitempty"`              // default true
+	HourColor                []int    `json:"hour-color,omitempty"`                // default [0,0,255]
+	MinuteColor              []int    `json:"minute-color,omitempty"`              // default [0,255,0]
+	SecondColor              []int    `json:"second-color,omitempty"`              // default [255,0,0]
+	MarkerEnabled            *bool    `json:"marker-enabled,omitempty"`            // default false
+	MarkerDepth              *int     `json:"marker-depth,omitempty"`              // >= 0, <= 4, default 0
+	MarkerWidth              *int     `json:"marker-width,omitempty"`              // >= 1, <= 10, default 5
+	MarkerColor              []int    `json:"marker-color,omitempty"`              // default [255,255,255]
+	BackgroundColor          []int    `json:"background-color,omitempty"`          // default [0,0,0]
+	SmoothingCustomSettings  *bool    `json:"smoothing-custom-settings,omitempty"` // default false
+	SmoothingTimeMs          *int     `json:"smoothing-time_ms,omitempty"`         // >= 25, <= 600, default 200
+	SmoothingUpdateFrequency *float64 `json:"smoothing-updateFrequency,omitempty"` // >= 1, <= 100, default 25
+}
+
+// Script of effect.
+func (LightClockArgs) Script() string {
+	return "light-clock.py"
+}
+
+// Args used by Effect.
+func (a LightClockArgs) Args() map[string]interface{} {
+	return toArgs(a)
+}
+
+// MoodBlobsArgs are arguments of mood-blobs.py effect, nil fields are defaulted by Hyperion.
+type MoodBlobsArgs struct {
+	RotationTime             *float64 `json:"rotationTime,omitempty"`              // >= 0, default 60
+	Color                    []int    `json:"color,omitempty"`                     // default [0,0,255]
+	ColorRandom              *bool    `json:"colorRandom,omitempty"`               // default false
+	HueChange                *float64 `json:"hueChange,omitempty"`                 // >= 0, <= 360, default 60
+	Blobs                    *int     `json:"blobs,omitempty"`                     // >= 1, <= 10, default 5
+	Reverse                  *bool    `json:"reverse,omitempty"`                   // default false
+	BaseChange               *bool    `json:"baseChange,omitempty"`                // default false
+	BaseColorRangeLeft       *float64 `json:"baseColorRangeLeft,omitempty"`        // >= 0, <= 360, default 0
+	BaseColorRangeRight      *float64 `json:"baseColorRangeRight,omitempty"`       // >= 0, <= 360, default 360
+	BaseColorChangeRate      *float64 `json:"baseColorChangeRate,omitempty"`       // >= 0, default 10
+	SmoothingCustomSettings  *bool    `json:"smoothing-custom-settings,omitempty"` // default false
+	SmoothingTimeMs          *int     `json:"smoothing-time_ms,omitempty"`         // >= 25, <= 600, default 200
+	SmoothingUpdateFrequency *float64 `json:"smoothing-updateFrequency,omitempty"` // >= 1, <= 100, default 25
+}
+
+// Script of effect.
+func (MoodBlobsArgs) Script() string {
+	return "mood-blobs.py"
+}
+
+// Args used by Effect.
+func (a MoodBlobsArgs) Args() map[string]interface{} {
+	return toArgs(a)
+}
+
+// PacmanArgs are arguments of pacman.py effect, nil fields are defaulted by Hyperion.
+type PacmanArgs struct {
+	RotationTime             *float64 `json:"rotationTime,omitempty"`              // >= 0.1, default 4
+	MarginPos                *float64 `json:"margin-pos,omitempty"`                // >= 0, default 2
+	SmoothingCustomSettings  *bool    `json:"smoothing-custom-settings,omitempty"` // default false
+	SmoothingTimeMs          *int     `json:"smoothing-time_ms,omitempty"`         // >= 25, <= 600, default 200
+	SmoothingUpdateFrequency *float64 `json:"smoothing-updateFrequency,omitempty"` // >= 1, <= 100, default 25
+}
+
+// Script of effect.
+func (PacmanArgs) Script() string {
+	return "pacman.py"
+}
+
+// Args used by Effect.
+func (a PacmanArgs) Args() map[string]interface{} {
+	return toArgs(a)
+}
+
+// PlasmaArgs are arguments of plasma.py effect, nil fields are defaulted by Hyperion.
+type PlasmaArgs struct {
+	SleepTime                *float64 `json:"sleepTime,omitempty"`                 // >= 0.01, default 0.2
+	SmoothingCustomSettings  *bool    `json:"smoothing-custom-settings,omitempty"` // default false
+	SmoothingTimeMs          *int     `json:"smoothing-time_ms,omitempty"`         // >= 25, <= 600, default 200
+	SmoothingUpdateFrequency *float64 `json:"smoothing-updateFrequency,omitempty"` // >= 1, <= 100, default 25
+}
+
+// Script of effect.
+func (PlasmaArgs) Script() string {
+	return "plasma.py"
+}
+
+// Args used by Effect.
+func (a PlasmaArgs) Args() map[string]interface{} {
+	return toArgs(a)
+}
+
+// PoliceArgs are arguments of police.py effect, nil fields are defaulted by Hyperion.
+type PoliceArgs struct {
+	RotationTime             *float64 `json:"rotation-time,omitempty"`             // >= 0.1, default 2
+	ColorOne                 []int    `json:"color_one,omitempty"`                 // default [255,0,0]
+	ColorTwo                 []int    `json:"color_two,omitempty"`                 // default [0,0,255]
+	ColorsCount              *int     `json:"colors_count,omitempty"`              // >= 1, default 10
+	Reverse                  *bool    `json:"reverse,omitempty"`                   // default false
+	SmoothingCustomSettings  *bool    `json:"smoothing-custom-settings,omitempty"` // default false
+	SmoothingTimeMs          *int     `json:"smoothing-time_ms,omitempty"`         // >= 25, <= 600, default 200
+	SmoothingUpdateFrequency *float64 `json:"smoothing-updateFrequency,omitempty"` // >= 1, <= 100, default 25
+}
+
+// Script of effect.
+func (PoliceArgs) Script() string {
+	return "police.py"
+}
+
+// Args used by Effect.
+func (a PoliceArgs) Args() map[string]interface{} {
+	return toArgs(a)
+}
+
+// RainbowMoodArgs are arguments of rainbow-mood.py effect, nil fields are defaulted by Hyperion.
+type RainbowMoodArgs struct {
+	RotationTime             *float64 `json:"rotation-time,omitempty"`             // >= 0.1, default 60
+	Brightness               *int     `json:"brightness,omitempty"`                // >= 0, <= 100, default 100
+	Reverse                  *bool    `json:"reverse,omitempty"`                   // default false
+	SmoothingCustomSettings  *bool    `json:"smoothing-custom-settings,omitempty"` // default false
+	SmoothingTimeMs          *int     `json:"smoothing-time_ms,omitempty"`         // >= 25, <= 600, default 200
+	SmoothingUpdateFrequency *float64 `json:"smoothing-updateFrequency,omitempty"` // >= 1, <= 100, default 25
+}
+
+// Script of effect.
+func (RainbowMoodArgs) Script() string {
+	return "rainbow-mood.py"
+}
+
+// Args used by Effect.
+func (a RainbowMoodArgs) Args() map[string]interface{} {
+	return toArgs(a)
+}
+
+// RandomArgs are arguments of random.py effect, nil fields are defaulted by Hyperion.
+type RandomArgs struct {
+	Speed                    *int     `json:"speed,omitempty"`                     // >= 100, default 750
+	Saturation               *float64 `json:"saturation,omitempty"`                // >= 0, <= 1, default 1
+	SmoothingCustomSettings  *bool    `json:"smoothing-custom-settings,omitempty"` // default false
+	SmoothingTimeMs          *int     `json:"smoothing-time_ms,omitempty"`         // >= 25, <= 600, default 200
+	SmoothingUpdateFrequency *float64 `json:"smoothing-updateFrequency,omitempty"` // >= 1, <= 100, default 25
+}
+
+// Script of effect.
+func (RandomArgs) Script() string {
+	return "random.py"
+}
+
+// Args used by Effect.
+func (a RandomArgs) Args() map[string]interface{} {
+	return toArgs(a)
+}
+
+// ShutdownArgs are arguments of shutdown.py effect, nil fields are defaulted by Hyperion.
+type ShutdownArgs struct {
+	Speed                    *float64 `json:"speed,omitempty"`                     // >= 0.1, <= 10, default 1.2
+	AlarmColor               []int    `json:"alarm-color,omitempty"`               // default [255,0,0]
+	PostColor                []int    `json:"post-color,omitempty"`                // default [255,174,11]
+	ShutdownEnabled          *bool    `json:"shutdown-enabled,omitempty"`          // default false
+	InitialBlink             *bool    `json:"initial-blink,omitempty"`             // default true
+	SetPostColor             *bool    `json:"set-post-color,omitempty"`            // default true
+	SmoothingCustomSettings  *bool    `json:"smoothing-custom-settings,omitempty"` // default false
+	SmoothingTimeMs          *int     `json:"smoothing-time_ms,omitempty"`         // >= 25, <= 600, default 200
+	SmoothingUpdateFrequency *float64 `json:"smoothing-updateFrequency,omitempty"` // >= 1, <= 100, default 25
+}
+
+// Script of effect.
+func (ShutdownArgs) Script() string {
+	return "shutdown.py"
+}
+
+// Args used by Effect.
+func (a ShutdownArgs) Args() map[string]interface{} {
+	return toArgs(a)
+}
+
+// SnakeArgs are arguments of snake.py effect, nil fields are defaulted by Hyperion.
+type SnakeArgs struct {
+	RotationTime             *float64 `json:"rotation-time,omitempty"`             // >= 0.1, default 12
+	Color                    []int    `json:"color,omitempty"`                     // default [255,0,0]
+	BackgroundColor          []int    `json:"background-color,omitempty"`          // default [0,0,0]
+	Percentage               *int     `json:"percentage,omitempty"`                // >= 1, <= 100, default 10
+	SmoothingCustomSettings  *bool    `json:"smoothing-custom-settings,omitempty"` // default false
+	SmoothingTimeMs          *int     `json:"smoothing-time_ms,omitempty"`         // >= 25, <= 600, default 200
+	SmoothingUpdateFrequency *float64 `json:"smoothing-updateFrequency,omitempty"` // >= 1, <= 100, default 25
+}
+
+// Script of effect.
+func (SnakeArgs) Script() string {
+	return "snake.py"
+}
+
+// Args used by Effect.
+func (a SnakeArgs) Args() map[string]interface{} {
+	return toArgs(a)
+}
+
+// SparksArgs are arguments of sparks.py effect, nil fields are defaulted by Hyperion.
+type SparksArgs struct {
+	RotationTime             *float64 `json:"rotation-time,omitempty"`             // >= 0.01, default 3
+	SleepTime                *float64 `json:"sleep-time,omitempty"`                // >= 0.01, default 0.05
+	Brightness               *int     `json:"brightness,omitempty"`                // >= 1, <= 100, default 100
+	Saturation               *int     `json:"saturation,omitempty"`                // >= 0, <= 100, default 100
+	Color                    []int    `json:"color,omitempty"`                     // default [255,255,255]
+	RandomColor              *bool    `json:"random-color,omitempty"`              // default false
+	SmoothingCustomSettings  *bool    `json:"smoothing-custom-settings,omitempty"` // default false
+	SmoothingTimeMs          *int     `json:"smoothing-time_ms,omitempty"`         // >= 25, <= 600, default 200
+	SmoothingUpdateFrequency *float64 `json:"smoothing-updateFrequency,omitempty"` // >= 1, <= 100, default 25
+}
+
+// Script of effect.
+func (SparksArgs) Script() string {
+	return "sparks.py"
+}
+
+// Args used by Effect.
+func (a SparksArgs) Args() map[string]interface{} {
+	return toArgs(a)
+}
+
+// SwirlArgs are arguments of swirl.py effect, nil fields are defaulted by Hyperion.
+type SwirlArgs struct {
+	RotationTime             *float64 `json:"rotation-time,omitempty"`             // >= 0.1, default 20
+	CenterX                  *float64 `json:"center_x,omitempty"`                  // >= 0, <= 1, default 0.5
+	CenterY                  *float64 `json:"center_y,omitempty"`                  // >= 0, <= 1, default 0.5
+	RandomCenter             *bool    `json:"random-center,omitempty"`             // default false
+	Reverse                  *bool    `json:"reverse,omitempty"`                   // default false
+	CustomColors             [][]int  `json:"custom-colors,omitempty"`             // default []
+	EnableSecond             *bool    `json:"enable-second,omitempty"`             // default false
+	CenterX2                 *float64 `json:"center_x2,omitempty"`                 // >= 0, <= 1, default 0.5
+	CenterY2                 *float64 `json:"center_y2,omitempty"`                 // >= 0, <= 1, default 0.5
+	RandomCenter2            *bool    `json:"random-center2,omitempty"`            // default false
+	Reverse2                 *bool    `json:"reverse2,omitempty"`                  // default false
+	CustomColors2            [][]int  `json:"custom-colors2,omitempty"`            // default []
+	SmoothingCustomSettings  *bool    `json:"smoothing-custom-settings,omitempty"` // default false
+	SmoothingTimeMs          *int     `json:"smoothing-time_ms,omitempty"`         // >= 25, <= 600, default 200
+	SmoothingUpdateFrequency *float64 `json:"smoothing-updateFrequency,omitempty"` // >= 1, <= 100, default 25
+}
+
+// Script of effect.
+func (SwirlArgs) Script() string {
+	return "swirl.py"
+}
+
+// Args used by Effect.
+func (a SwirlArgs) Args() map[string]interface{} {
+	return toArgs(a)
+}
+
+// TracesArgs are arguments of traces.py effect, nil fields are defaulted by Hyperion.
+type TracesArgs struct {
+	Speed                    *float64 `json:"speed,omitempty"`                     // >= 0.1, default 1
+	SmoothingCustomSettings  *bool    `json:"smoothing-custom-settings,omitempty"` // default false
+	SmoothingTimeMs          *int     `json:"smoothing-time_ms,omitempty"`         // >= 25, <= 600, default 200
+	SmoothingUpdateFrequency *float64 `json:"smoothing-updateFrequency,omitempty"` // >= 1, <= 100, default 25
+}
+
+// Script of effect.
+func (TracesArgs) Script() string {
+	return "traces.py"
+}
+
+// Args used by Effect.
+func (a TracesArgs) Args() map[string]interface{} {
+	return toArgs(a)
+}
+
+// TrailsArgs are arguments of trails.py effect, nil fields are defaulted by Hyperion.
+type TrailsArgs struct {
+	Speed                    *int     `json:"speed,omitempty"`                     // >= 1, <= 100, default 50
+	MinLen                   *int     `json:"min_len,omitempty"`                   // >= 1, default 2
+	MaxLen                   *int     `json:"max_len,omitempty"`                   // >= 1, default 6
+	Height                   *int     `json:"height,omitempty"`                    // >= 1, default 8
+	Trails                   *int     `json:"trails,omitempty"`                    // >= 1, default 16
+	Color                    []int    `json:"color,omitempty"`                     // default [255,255,255]
+	Random                   *bool    `json:"random,omitempty"`                    // default false
+	SmoothingCustomSettings  *bool    `json:"smoothing-custom-settings,omitempty"` // default false
+	SmoothingTimeMs          *int     `json:"smoothing-time_ms,omitempty"`         // >= 25, <= 600, default 200
+	SmoothingUpdateFrequency *float64 `json:"smoothing-updateFrequency,omitempty"` // >= 1, <= 100, default 25
+}
+
+// Script of effect.
+func (TrailsArgs) Script() string {
+	return "trails.py"
+}
+
+// Args used by Effect.
+func (a TrailsArgs) Args() map[string]interface{} {
+	return toArgs(a)
+}
+
+// WavesArgs are arguments of waves.py effect, nil fields are defaulted by Hyperion.
+type WavesArgs struct {
+	Reverse                  *bool    `json:"reverse,omitempty"`                   // default false
+	CenterX                  *float64 `json:"center_x,omitempty"`                  // >= -1, <= 2, default 0.5
+	CenterY                  *float64 `json:"center_y,omitempty"`                  // >= -1, <= 2, default 0.5
+	RandomCenter             *bool    `json:"random-center,omitempty"`             // default false
+	RotationTime             *float64 `json:"rotation_time,omitempty"`             // >= 0.1, default 60
+	ReverseTime              *int     `json:"reverse_time,omitempty"`              // >= 0, default 0
+	Colors                   [][]int  `json:"colors,omitempty"`                    // default []
+	SmoothingCustomSettings  *bool    `json:"smoothing-custom-settings,omitempty"` // default false
+	SmoothingTimeMs          *int     `json:"smoothing-time_ms,omitempty"`         // >= 25, <= 600, default 200
+	SmoothingUpdateFrequency *float64 `json:"smoothing-updateFrequency,omitempty"` // >= 1, <= 100, default 25
+}
+
+// Script of effect.
+func (WavesArgs) Script() string {
+	return "waves.py"
+}
+
+// Args used by Effect.
+func (a WavesArgs) Args() map[string]interface{} {
+	return toArgs(a)
+}
+
+// XMasArgs are arguments of x-mas.py effect, nil fields are defaulted by Hyperion.
+type XMasArgs struct {
+	SleepTime                *int     `json:"sleepTime,omitempty"`                 // >= 100, default 750
+	Color1                   []int    `json:"color1,omitempty"`                    // default [255,255,255]
+	Color2                   []int    `json:"color2,omitempty"`                    // default [255,0,0]
+	Length                   *int     `json:"length,omitempty"`                    // >= 1, default 1
+	SmoothingCustomSettings  *bool    `json:"smoothing-custom-settings,omitempty"` // default false
+	SmoothingTimeMs          *int     `json:"smoothing-time_ms,omitempty"`         // >= 25, <= 600, default 200
+	SmoothingUpdateFrequency *float64 `json:"smoothing-updateFrequency,omitempty"` // >= 1, <= 100, default 25
+}
+
+// Script of effect.
+func (XMasArgs) Script() string {
+	return "x-mas.py"
+}
+
+// Args used by Effect.
+func (a XMasArgs) Args() map[string]interface{} {
+	return toArgs(a)
+}
@@ -0,0 +1,161 @@
+package model
+
+//go:generate go run ../internal/cmd/effectgen -in ../internal/cmd/effectgen/effectschemas.json -out effectargs.go
+
+import (
+	"encoding/json"
+	"path"
+	"sort"
+)
+
+// EffectSchema describes arguments of effect script.
+type EffectSchema struct {
+	Script     string        // File name of script, e.g. mood-blobs.py
+	Title      string        // Translation key of Hyperion UI
+	Params     []EffectParam // Ordered as in Hyperion UI
+	Additional bool          // Arguments not described by schema are allowed
+}
+
+// EffectParam describes single argument of effect.
+type EffectParam struct {
+	Name     string
+	Type     string // integer, number, boolean, string, array
+	Default  interface{}
+	Minimum  *float64
+	Maximum  *float64
+	Enum     []interface{}
+	Items    *EffectParam // Type of array items
+	MinItems *int
+	MaxItems *int
+}
+
+// Param by name.
+func (s EffectSchema) Param(name string) *EffectParam {
+	for _, p := range s.Params {
+		if p.Name == name {
+			return &p
+		}
+	}
+	return nil
+}
+
+// jsonSchema is subset of JSON schema used by Hyperion effects.
+type jsonSchema struct {
+	Type                 string                `json:"type"`
+	Script               string                `json:"script"`
+	Title                string                `json:"title"`
+	Default              interface{}           `json:"default"`
+	Minimum              *float64              `json:"minimum"`
+	Maximum              *float64              `json:"maximum"`
+	Enum                 []interface{}         `json:"enum"`
+	Items                *jsonSchema           `json:"items"`
+	MinItems             *int                  `json:"minItems"`
+	MaxItems             *int                  `json:"maxItems"`
+	Properties           map[string]jsonSchema `json:"properties"`
+	PropertyOrder        int                   `json:"propertyOrder"`
+	AdditionalProperties *bool                 `json:"additionalProperties"`
+}
+
+func (j jsonSchema) param(name string) EffectParam {
+	p := EffectParam{
+		Name:     name,
+		Type:     j.Type,
+		Default:  j.Default,
+		Minimum:  j.Minimum,
+		Maximum:  j.Maximum,
+		Enum:     j.Enum,
+		MinItems: j.MinItems,
+		MaxItems: j.MaxItems,
+	}
+
+	if j.Items != nil {
+		items := j.Items.param("")
+		p.Items = &items
+	}
+
+	return p
+}
+
+// UnmarshalJSON decodes JSON schema of effect.
+func (s *EffectSchema) UnmarshalJSON(b []byte) error {
+	schema := jsonSchema{}
+	if err := json.Unmarshal(b, &schema); err != nil {
+		return err
+	}
+
+	*s = EffectSchema{
+		Script:     schema.Script,
+		Title:      schema.Title,
+		Additional: schema.AdditionalProperties == nil || *schema.AdditionalProperties,
+	}
+
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		oi, oj := schema.Properties[names[i]].PropertyOrder, schema.Properties[names[j]].PropertyOrder
+		if oi != oj {
+			return oi < oj
+		}
+		return names[i] < names[j]
+	})
+
+	for _, name := range names {
+		s.Params = append(s.Params, schema.Properties[name].param(name))
+	}
+
+	return nil
+}
+
+// EffectSchemas of effect scripts by file name, e.g. mood-blobs.py.
+type EffectSchemas map[string]EffectSchema
+
+// Find schema of effect script, script could be full path like ":/effects/mood-blobs.py".
+func (e EffectSchemas) Find(script string) *EffectSchema {
+	if schema, ok := e[path.Base(script)]; ok {
+		return &schema
+	}
+	return nil
+}
+
+// UnmarshalJSON decodes effect schemas from settings schema, see Client.GetSchema.
+func (e *EffectSchemas) UnmarshalJSON(b []byte) error {
+	type entry struct {
+		Script  string       `json:"script"`
+		Content EffectSchema `json:"schemaContent"`
+	}
+
+	schema := struct {
+		Properties struct {
+			EffectSchemas struct {
+				Internal []entry `json:"internal"`
+				External []entry `json:"external"`
+			} `json:"effectSchemas"`
+		} `json:"properties"`
+	}{}
+
+	if err := json.Unmarshal(b, &schema); err != nil {
+		return err
+	}
+
+	*e = EffectSchemas{}
+	for _, entry := range append(schema.Properties.EffectSchemas.Internal, schema.Properties.EffectSchemas.External...) {
+		if entry.Content.Script == "" {
+			entry.Content.Script = path.Base(entry.Script)
+		}
+
+		(*e)[entry.Content.Script] = entry.Content
+	}
+
+	return nil
+}
+
+// toArgs converts typed arguments to Effect.Args.
+func toArgs(args interface{}) map[string]interface{} {
+	b, _ := json.Marshal(args)
+	data := map[string]interface{}{}
+	_ = json.Unmarshal(b, &data)
+	return data
+}
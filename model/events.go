@@ -0,0 +1,191 @@
+package model
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"image"
+	"image/color"
+	_ "image/jpeg" // image stream format
+	_ "image/png"  // image stream format
+	"strings"
+	"time"
+)
+
+// EventType of update pushed by Hyperion to subscribers.
+type EventType string
+
+const (
+	EventComponents        EventType = "components-update"
+	EventPriorities        EventType = "priorities-update"
+	EventAdjustment        EventType = "adjustment-update"
+	EventVideoMode         EventType = "videomode-update"
+	EventEffects           EventType = "effects-update"
+	EventInstance          EventType = "instance-update"
+	EventLeds              EventType = "leds-update"
+	EventImageToLedMapping EventType = "imageToLedMapping-update"
+	EventSettings          EventType = "settings-update"
+	EventLedColors         EventType = "ledcolors-ledstream-update"
+	EventLedImage          EventType = "ledcolors-imagestream-update"
+	EventLog               EventType = "logmsg-update"
+)
+
+// Event pushed by Hyperion, only fields related to Type are set,
+// payload of every event is available in Data.
+type Event struct {
+	Type     EventType
+	Instance int
+
+	Component             *Component   // EventComponents
+	Priorities            []Priority   // EventPriorities
+	PrioritiesAutoselect  bool         // EventPriorities
+	Adjustments           []Adjustment // EventAdjustment
+	VideoMode             string       // EventVideoMode
+	Effects               Effects      // EventEffects
+	Instances             Instances    // EventInstance
+	Leds                  []Led        // EventLeds
+	ImageToLedMappingType string       // EventImageToLedMapping
+	Settings              *Settings    // EventSettings, changed sections only
+	LedColors             []color.RGBA // EventLedColors, aligned with Information.Leds
+	Image                 image.Image  // EventLedImage
+	Logs                  []LogEntry   // EventLog
+
+	Data json.RawMessage
+}
+
+// UnmarshalJSON decodes pushed message by its type.
+func (e *Event) UnmarshalJSON(b []byte) error {
+	msg := struct {
+		Command  EventType       `json:"command"`
+		Instance int             `json:"instance"`
+		Data     json.RawMessage `json:"data"`
+		Result   json.RawMessage `json:"result"` // used by ledcolors
+	}{}
+
+	if err := json.Unmarshal(b, &msg); err != nil {
+		return err
+	}
+
+	if len(msg.Data) == 0 {
+		msg.Data = msg.Result
+	}
+
+	*e = Event{Type: msg.Command, Instance: msg.Instance, Data: msg.Data}
+	if len(msg.Data) == 0 {
+		return nil
+	}
+
+	switch e.Type {
+	case EventComponents:
+		e.Component = &Component{}
+		return json.Unmarshal(msg.Data, e.Component)
+	case EventPriorities:
+		data := struct {
+			Priorities []Priority `json:"priorities"`
+			Autoselect bool       `json:"priorities_autoselect"`
+		}{}
+		err := json.Unmarshal(msg.Data, &data)
+		e.Priorities, e.PrioritiesAutoselect = data.Priorities, data.Autoselect
+		return err
+	case EventAdjustment:
+		return json.Unmarshal(msg.Data, &e.Adjustments)
+	case EventVideoMode:
+		data := struct {
+			VideoMode string `json:"videomode"`
+		}{}
+		err := json.Unmarshal(msg.Data, &data)
+		e.VideoMode = data.VideoMode
+		return err
+	case EventEffects:
+		data := struct {
+			Effects Effects `json:"effects"`
+		}{}
+		err := json.Unmarshal(msg.Data, &data)
+		e.Effects = data.Effects
+		return err
+	case EventInstance:
+		return json.Unmarshal(msg.Data, &e.Instances)
+	case EventLeds:
+		data := struct {
+			Leds []Led `json:"leds"`
+		}{}
+		err := json.Unmarshal(msg.Data, &data)
+		e.Leds = data.Leds
+		return err
+	case EventImageToLedMapping:
+		data := struct {
+			Type string `json:"imageToLedMappingType"`
+		}{}
+		err := json.Unmarshal(msg.Data, &data)
+		e.ImageToLedMappingType = data.Type
+		return err
+	case EventSettings:
+		e.Settings = &Settings{}
+		return json.Unmarshal(msg.Data, e.Settings)
+	case EventLedColors:
+		data := struct {
+			Leds []int `json:"leds"` // R, G, B of every LED
+		}{}
+		if err := json.Unmarshal(msg.Data, &data); err != nil {
+			return err
+		}
+
+		e.LedColors = make([]color.RGBA, len(data.Leds)/3)
+		for i := range e.LedColors {
+			e.LedColors[i] = color.RGBA{R: uint8(data.Leds[3*i]), G: uint8(data.Leds[3*i+1]), B: uint8(data.Leds[3*i+2]), A: 255}
+		}
+	case EventLog:
+		data := struct {
+			Messages []LogEntry `json:"messages"`
+		}{}
+		err := json.Unmarshal(msg.Data, &data)
+		e.Logs = data.Messages
+		return err
+	case EventLedImage:
+		data := struct {
+			Image string `json:"image"` // data URL
+		}{}
+		if err := json.Unmarshal(msg.Data, &data); err != nil {
+			return err
+		}
+
+		img, err := decodeDataURL(data.Image)
+		e.Image = img
+		return err
+	}
+
+	return nil
+}
+
+// LogEntry of Hyperion log.
+type LogEntry struct {
+	Level     string `json:"levelString"` // DEBUG, INFO, WARNING, ERROR
+	Timestamp int64  `json:"utime"`       // Unix time in milliseconds
+	Logger    string `json:"loggerName"`
+	Function  string `json:"function"`
+	File      string `json:"fileName"`
+	Line      int    `json:"line"`
+	Message   string `json:"message"`
+}
+
+// Time of log entry.
+func (l LogEntry) Time() time.Time {
+	return time.UnixMilli(l.Timestamp)
+}
+
+// decodeDataURL decodes base64 encoded image like "data:image/jpg;base64,...".
+func decodeDataURL(url string) (image.Image, error) {
+	_, b64, ok := strings.Cut(url, ";base64,")
+	if !ok {
+		return nil, errors.New("image is not base64 data URL")
+	}
+
+	b, err := base64.StdEncoding.DecodeString(b64)
+	if err != nil {
+		return nil, err
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(b))
+	return img, err
+}
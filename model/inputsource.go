@@ -0,0 +1,78 @@
+package model
+
+// Input source types.
+const (
+	InputSourceVideo  = "video"
+	InputSourceScreen = "screen"
+	InputSourceAudio  = "audio"
+)
+
+// InputSourceDiscovery result of input source discovery.
+type InputSourceDiscovery struct {
+	Type  string        `json:"sourceType"`
+	Video []InputSource `json:"video_sources"` // V4L2 and screen grabbers
+	Audio []InputSource `json:"audio_sources"`
+}
+
+// InputSource is capture device or grabber.
+type InputSource struct {
+	Device string        `json:"device"` // e.g. /dev/video0 or grabber type like qt, x11
+	Name   string        `json:"device_name"`
+	Type   string        `json:"type"` // v4l2, screen, audio
+	Inputs []SourceInput `json:"video_inputs"`
+
+	Default struct {
+		Input *struct {
+			Index      int        `json:"inputIdx"`
+			Resolution Resolution `json:"resolution"`
+		} `json:"video_input"`
+	} `json:"default"`
+}
+
+// SourceInput of capture device, e.g. screen of screen grabber.
+type SourceInput struct {
+	Index     int            `json:"inputIdx"`
+	Name      string         `json:"name"`
+	Standards []string       `json:"standards"` // PAL, NTSC
+	Formats   []SourceFormat `json:"formats"`
+}
+
+// SourceFormat is encoding with supported resolutions.
+type SourceFormat struct {
+	Format      string       `json:"format"` // yuyv, mjpeg, ... empty for screen grabbers
+	Resolutions []Resolution `json:"resolutions"`
+}
+
+// Resolution with supported frame rates.
+type Resolution struct {
+	Width  int   `json:"width"`
+	Height int   `json:"height"`
+	FPS    []int `json:"fps"`
+}
+
+// V4L2Settings for grabberV4L2 section of settings to capture from given input.
+func (s InputSource) V4L2Settings(input SourceInput, format SourceFormat, res Resolution, fps int) *GrabberV4L2Settings {
+	enable := true
+	return &GrabberV4L2Settings{
+		Enable:   &enable,
+		Device:   &s.Device,
+		Input:    &input.Index,
+		Encoding: &format.Format,
+		Width:    &res.Width,
+		Height:   &res.Height,
+		FPS:      &fps,
+	}
+}
+
+// FrameGrabberSettings for framegrabber section of settings to capture given screen.
+func (s InputSource) FrameGrabberSettings(input SourceInput, res Resolution, fps int) *FrameGrabberSettings {
+	enable := true
+	return &FrameGrabberSettings{
+		Enable: &enable,
+		Device: &s.Device,
+		Input:  &input.Index,
+		Width:  &res.Width,
+		Height: &res.Height,
+		FPS:    &fps,
+	}
+}
@@ -0,0 +1,198 @@
+package model
+
+import "encoding/json"
+
+// LED device types with typed properties, see Information.LedDevices.Available for all types.
+const (
+	LedDeviceWLED      = "wled"
+	LedDeviceHue       = "philipshue"
+	LedDeviceNanoleaf  = "nanoleaf"
+	LedDeviceYeelight  = "yeelight"
+	LedDeviceCololight = "cololight"
+	LedDeviceRazer     = "razer"
+)
+
+// LedDeviceParams of leddevice request, fields are specific to device type.
+type LedDeviceParams struct {
+	Host      string `json:"host,omitempty"`
+	Port      int    `json:"port,omitempty"`
+	Output    string `json:"output,omitempty"`    // Serial port of USB devices
+	User      string `json:"user,omitempty"`      // Philips Hue user
+	ClientKey string `json:"clientkey,omitempty"` // Philips Hue Entertainment key
+	Token     string `json:"token,omitempty"`     // Nanoleaf auth token
+	LightIDs  []int  `json:"lightIds,omitempty"`  // Philips Hue lights to identify
+}
+
+// LedDeviceDiscovery result of device discovery.
+type LedDeviceDiscovery struct {
+	Type    string             `json:"ledDeviceType"`
+	Method  string             `json:"discoveryMethod"` // mDNS, ssdp, serial, ...
+	Devices []DiscoveredDevice `json:"devices"`
+}
+
+// DiscoveredDevice is network or serial device, only fields of its kind are set.
+type DiscoveredDevice struct {
+	Name     string            `json:"name"`
+	Hostname string            `json:"hostname"`
+	IP       string            `json:"ip"`
+	Port     int               `json:"port"`
+	Domain   string            `json:"domain"`
+	Service  string            `json:"service"`
+	ID       string            `json:"id"`      // Philips Hue bridge
+	ModelID  string            `json:"modelid"` // Philips Hue bridge
+	Txt      map[string]string `json:"txt"`     // mDNS TXT record
+
+	PortName       string `json:"portName"`
+	SystemLocation string `json:"systemLocation"`
+	Description    string `json:"description"`
+	Manufacturer   string `json:"manufacturer"`
+	SerialNumber   string `json:"serialNumber"`
+	VendorID       string `json:"vendorIdentifier"`
+	ProductID      string `json:"productIdentifier"`
+}
+
+// LedDeviceProperties of device, typed accessors are provided for WLED, Philips Hue, Nanoleaf,
+// Yeelight, Cololight and Razer Chroma, decode Properties of other types.
+type LedDeviceProperties struct {
+	Type       string          `json:"ledDeviceType"`
+	Properties json.RawMessage `json:"properties"`
+}
+
+// WLED properties of WLED device.
+func (l LedDeviceProperties) WLED() (*WLEDProperties, error) {
+	p := &WLEDProperties{}
+	return p, json.Unmarshal(l.Properties, p)
+}
+
+// Hue properties of Philips Hue bridge.
+func (l LedDeviceProperties) Hue() (*HueProperties, error) {
+	p := &HueProperties{}
+	return p, json.Unmarshal(l.Properties, p)
+}
+
+// Nanoleaf properties of Nanoleaf controller.
+func (l LedDeviceProperties) Nanoleaf() (*NanoleafProperties, error) {
+	p := &NanoleafProperties{}
+	return p, json.Unmarshal(l.Properties, p)
+}
+
+// Yeelight properties of Yeelight lamp.
+func (l LedDeviceProperties) Yeelight() (*YeelightProperties, error) {
+	p := &YeelightProperties{}
+	return p, json.Unmarshal(l.Properties, p)
+}
+
+// Cololight properties of Cololight device.
+func (l LedDeviceProperties) Cololight() (*CololightProperties, error) {
+	p := &CololightProperties{}
+	return p, json.Unmarshal(l.Properties, p)
+}
+
+// Razer properties of Razer Chroma SDK.
+func (l LedDeviceProperties) Razer() (*RazerProperties, error) {
+	p := &RazerProperties{}
+	return p, json.Unmarshal(l.Properties, p)
+}
+
+// WLEDProperties of WLED device.
+type WLEDProperties struct {
+	Info struct {
+		Name    string `json:"name"`
+		Version string `json:"ver"`
+		Arch    string `json:"arch"`
+		MAC     string `json:"mac"`
+		UDPPort int    `json:"udpport"`
+		Leds    struct {
+			Count    int  `json:"count"`
+			RGBW     bool `json:"rgbw"`
+			MaxPower int  `json:"maxpwr"` // Milliamperes
+			MaxSeg   int  `json:"maxseg"`
+		} `json:"leds"`
+	} `json:"info"`
+
+	State struct {
+		On         bool `json:"on"`
+		Brightness int  `json:"bri"`
+		Live       bool `json:"live"`
+	} `json:"state"`
+}
+
+// HueProperties of Philips Hue bridge.
+type HueProperties struct {
+	Name       string `json:"name"`
+	BridgeID   string `json:"bridgeid"`
+	ModelID    string `json:"modelid"`
+	APIVersion string `json:"apiversion"`
+	SWVersion  string `json:"swversion"`
+	MAC        string `json:"mac"`
+
+	Lights map[string]struct {
+		Name    string `json:"name"`
+		Type    string `json:"type"`
+		ModelID string `json:"modelid"`
+	} `json:"lights"`
+
+	Groups map[string]struct {
+		Name   string   `json:"name"`
+		Type   string   `json:"type"` // Entertainment groups are used for streaming
+		Lights []string `json:"lights"`
+	} `json:"groups"`
+}
+
+// NanoleafProperties of Nanoleaf controller.
+type NanoleafProperties struct {
+	Name            string `json:"name"`
+	SerialNo        string `json:"serialNo"`
+	Manufacturer    string `json:"manufacturer"`
+	Model           string `json:"model"`
+	FirmwareVersion string `json:"firmwareVersion"`
+
+	PanelLayout struct {
+		Layout struct {
+			NumPanels    int `json:"numPanels"`
+			PositionData []struct {
+				PanelID   int `json:"panelId"`
+				X         int `json:"x"`
+				Y         int `json:"y"`
+				O         int `json:"o"`
+				ShapeType int `json:"shapeType"`
+			} `json:"positionData"`
+		} `json:"layout"`
+	} `json:"panelLayout"`
+}
+
+// YeelightProperties of Yeelight lamp, values are reported by lamp as strings.
+type YeelightProperties struct {
+	Name       string `json:"name"`
+	Model      string `json:"model"`
+	FWVersion  string `json:"fw_ver"`
+	Power      string `json:"power"` // on or off
+	Brightness string `json:"bright"`
+	ColorMode  string `json:"color_mode"` // 1 RGB, 2 color temperature, 3 HSV
+	CT         string `json:"ct"`
+	RGB        string `json:"rgb"`
+	Hue        string `json:"hue"`
+	Sat        string `json:"sat"`
+	MusicOn    string `json:"music_on"`
+}
+
+// CololightProperties of Cololight device.
+type CololightProperties struct {
+	ModelType       string `json:"modelType"` // Strip or Plus
+	LedCount        int    `json:"ledCount"`
+	FirmwareVersion string `json:"firmwareVersion"`
+}
+
+// RazerProperties of Razer Chroma SDK.
+type RazerProperties struct {
+	Core    string `json:"core"`
+	Device  string `json:"device"`
+	Version string `json:"version"`
+}
+
+// LedDeviceAuth credentials created by device, only fields of device type are set.
+type LedDeviceAuth struct {
+	User      string `json:"user"`       // Philips Hue
+	ClientKey string `json:"clientkey"`  // Philips Hue
+	Token     string `json:"auth_token"` // Nanoleaf
+}
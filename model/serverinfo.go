@@ -1,6 +1,7 @@
 package model
 
 import (
+	"maps"
 	"slices"
 	"strings"
 )
@@ -19,6 +20,8 @@ type Information struct {
 	Priorities            []Priority               `json:"priorities"`
 	PrioritiesAutoselect  bool                     `json:"priorities_autoselect"`
 	Instances             Instances                `json:"instance"`
+	Suspended             bool                     `json:"suspended"` // Hyperion is suspended
+	Idle                  bool                     `json:"idle"`      // Hyperion is idle
 
 	Grabbers struct {
 		Audio  Grabber `json:"audio"`
@@ -30,8 +33,9 @@ type Information struct {
 		Available []string `json:"available"`
 	} `json:"ledDevices"`
 
-	Leds     []Led    `json:"leds"`
-	Services []string `json:"services"`
+	Leds     []Led     `json:"leds"`
+	Services []string  `json:"services"`
+	Sessions []Session `json:"sessions"` // Other Hyperion servers found on the network
 }
 
 // Effects list of Effect's.
@@ -103,6 +107,22 @@ type Effect struct {
 	Script string                 `json:"script,omitempty"` // Optional
 }
 
+// Derive new effect with the same script, args are copied and overridden by given args.
+func (e Effect) Derive(name string, args map[string]interface{}) Effect {
+	derived := Effect{
+		Name:   name,
+		Script: e.Script,
+		Args:   maps.Clone(e.Args),
+	}
+
+	if derived.Args == nil {
+		derived.Args = map[string]interface{}{}
+	}
+
+	maps.Copy(derived.Args, args)
+	return derived
+}
+
 // ActiveEffect active effect info.
 type ActiveEffect struct {
 	Script   string                 `json:"script"`
@@ -140,6 +160,16 @@ func (i Instances) Find(instance int) *Instance {
 	return nil
 }
 
+// FindName instance by name, case is ignored.
+func (i Instances) FindName(name string) *Instance {
+	for _, ins := range i {
+		if strings.EqualFold(ins.Name, name) {
+			return &ins
+		}
+	}
+	return nil
+}
+
 // Instance information and their state.
 type Instance struct {
 	Instance int    `json:"instance"`
@@ -155,6 +185,16 @@ type Led struct {
 	VMax float64 `json:"vmax"`
 }
 
+// Session of Hyperion server found on the network.
+type Session struct {
+	Name    string `json:"name"`
+	Host    string `json:"host"`
+	Address string `json:"address"`
+	Port    int    `json:"port"`
+	Type    string `json:"type"` // e.g. _hyperiond-http._tcp.
+	Domain  string `json:"domain"`
+}
+
 // Grabber state information.
 type Grabber struct {
 	Active    []string `json:"active"`
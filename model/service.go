@@ -0,0 +1,37 @@
+package model
+
+// Service types of Hyperion servers.
+const (
+	ServiceJSONAPI     = "jsonapi"
+	ServiceFlatbuffer  = "flatbuffer"
+	ServiceProtobuffer = "protobuffer"
+)
+
+// ServiceDiscovery result of service discovery.
+type ServiceDiscovery struct {
+	Type     string    `json:"serviceType"`
+	Services []Service `json:"services"`
+}
+
+// Service of Hyperion server found on the network.
+type Service struct {
+	Name     string `json:"name"`
+	Hostname string `json:"hostname"`
+	IP       string `json:"ip"`
+	Port     int    `json:"port"`
+	Type     string `json:"type"` // e.g. _hyperiond-json._tcp
+	Domain   string `json:"domain"`
+	Service  string `json:"service"` // Full mDNS name
+}
+
+// Session converts service to session entry.
+func (s Service) Session() Session {
+	return Session{
+		Name:    s.Name,
+		Host:    s.Hostname,
+		Address: s.IP,
+		Port:    s.Port,
+		Type:    s.Type,
+		Domain:  s.Domain,
+	}
+}
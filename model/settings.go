@@ -0,0 +1,152 @@
+package model
+
+import "encoding/json"
+
+// Settings of Hyperion grouped by sections, common sections are typed
+// and every section is available as raw JSON in Raw.
+// Typed sections have optional fields, so they could be used for partial update.
+type Settings struct {
+	Device              *DeviceSettings              `json:"device,omitempty"`
+	Smoothing           *SmoothingSettings           `json:"smoothing,omitempty"`
+	BlackBorderDetector *BlackBorderDetectorSettings `json:"blackborderdetector,omitempty"`
+	GrabberV4L2         *GrabberV4L2Settings         `json:"grabberV4L2,omitempty"`
+	FrameGrabber        *FrameGrabberSettings        `json:"framegrabber,omitempty"`
+	Forwarder           *ForwarderSettings           `json:"forwarder,omitempty"`
+	JSONServer          *JSONServerSettings          `json:"jsonServer,omitempty"`
+	FlatbufServer       *FlatbufServerSettings       `json:"flatbufServer,omitempty"`
+
+	Raw map[string]json.RawMessage `json:"-"` // All sections by name, typed sections take precedence on update
+}
+
+// settings without custom marshaling.
+type settings Settings
+
+// UnmarshalJSON decodes typed sections and keeps all sections in Raw.
+func (s *Settings) UnmarshalJSON(b []byte) error {
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	typed := settings{}
+	if err := json.Unmarshal(b, &typed); err != nil {
+		return err
+	}
+
+	*s = Settings(typed)
+	s.Raw = raw
+	return nil
+}
+
+// MarshalJSON encodes Raw sections overridden by typed sections.
+func (s Settings) MarshalJSON() ([]byte, error) {
+	typed, err := json.Marshal(settings(s))
+	if err != nil {
+		return nil, err
+	}
+
+	sections := map[string]json.RawMessage{}
+	if err = json.Unmarshal(typed, &sections); err != nil {
+		return nil, err
+	}
+
+	for name, section := range s.Raw {
+		if _, ok := sections[name]; !ok {
+			sections[name] = section
+		}
+	}
+
+	return json.Marshal(sections)
+}
+
+// DeviceSettings of LED device, device specific options are available in Settings.Raw.
+type DeviceSettings struct {
+	Type             *string `json:"type,omitempty"`
+	Output           *string `json:"output,omitempty"`
+	Host             *string `json:"host,omitempty"`
+	Port             *int    `json:"port,omitempty"`
+	Rate             *int    `json:"rate,omitempty"`
+	ColorOrder       *string `json:"colorOrder,omitempty"`
+	HardwareLedCount *int    `json:"hardwareLedCount,omitempty"`
+	RewriteTime      *int    `json:"rewriteTime,omitempty"` // Milliseconds
+	LatchTime        *int    `json:"latchTime,omitempty"`   // Milliseconds
+	AutoStart        *bool   `json:"autoStart,omitempty"`
+}
+
+// SmoothingSettings of color transitions.
+type SmoothingSettings struct {
+	Enable            *bool    `json:"enable,omitempty"`
+	Type              *string  `json:"type,omitempty"` // linear, decay
+	Time              *int     `json:"time_ms,omitempty"`
+	UpdateFrequency   *float64 `json:"updateFrequency,omitempty"`
+	InterpolationRate *float64 `json:"interpolationRate,omitempty"`
+	OutputRate        *float64 `json:"outputRate,omitempty"`
+	Decay             *float64 `json:"decay,omitempty"`
+	Dithering         *bool    `json:"dithering,omitempty"`
+	UpdateDelay       *int     `json:"updateDelay,omitempty"`
+}
+
+// BlackBorderDetectorSettings of image processing.
+type BlackBorderDetectorSettings struct {
+	Enable             *bool    `json:"enable,omitempty"`
+	Threshold          *float64 `json:"threshold,omitempty"` // Percent
+	UnknownFrameCnt    *int     `json:"unknownFrameCnt,omitempty"`
+	BorderFrameCnt     *int     `json:"borderFrameCnt,omitempty"`
+	MaxInconsistentCnt *int     `json:"maxInconsistentCnt,omitempty"`
+	BlurRemoveCnt      *int     `json:"blurRemoveCnt,omitempty"`
+	Mode               *string  `json:"mode,omitempty"` // default, classic, osd, letterbox
+}
+
+// GrabberV4L2Settings of USB capture device.
+type GrabberV4L2Settings struct {
+	Enable                *bool   `json:"enable,omitempty"`
+	Device                *string `json:"device,omitempty"`
+	Input                 *int    `json:"input,omitempty"`
+	Encoding              *string `json:"encoding,omitempty"`
+	Width                 *int    `json:"width,omitempty"`
+	Height                *int    `json:"height,omitempty"`
+	FPS                   *int    `json:"fps,omitempty"`
+	Flip                  *string `json:"flip,omitempty"`
+	FPSSoftwareDecimation *int    `json:"fpsSoftwareDecimation,omitempty"`
+	SizeDecimation        *int    `json:"sizeDecimation,omitempty"`
+	CropLeft              *int    `json:"cropLeft,omitempty"`
+	CropRight             *int    `json:"cropRight,omitempty"`
+	CropTop               *int    `json:"cropTop,omitempty"`
+	CropBottom            *int    `json:"cropBottom,omitempty"`
+	SignalDetection       *bool   `json:"signalDetection,omitempty"`
+	CECDetection          *bool   `json:"cecDetection,omitempty"`
+}
+
+// FrameGrabberSettings of screen capture.
+type FrameGrabberSettings struct {
+	Enable          *bool   `json:"enable,omitempty"`
+	Device          *string `json:"device,omitempty"`
+	Input           *int    `json:"input,omitempty"`
+	Width           *int    `json:"width,omitempty"`
+	Height          *int    `json:"height,omitempty"`
+	FPS             *int    `json:"fps,omitempty"`
+	PixelDecimation *int    `json:"pixelDecimation,omitempty"`
+	CropLeft        *int    `json:"cropLeft,omitempty"`
+	CropRight       *int    `json:"cropRight,omitempty"`
+	CropTop         *int    `json:"cropTop,omitempty"`
+	CropBottom      *int    `json:"cropBottom,omitempty"`
+}
+
+// ForwarderSettings to other Hyperion servers.
+type ForwarderSettings struct {
+	Enable  *bool    `json:"enable,omitempty"`
+	JSON    []string `json:"json,omitempty"` // Targets as host:port
+	Flatbuf []string `json:"flat,omitempty"` // Targets as host:port
+}
+
+// JSONServerSettings of JSON API server.
+type JSONServerSettings struct {
+	Port *int `json:"port,omitempty"`
+}
+
+// FlatbufServerSettings of FlatBuffers server.
+type FlatbufServerSettings struct {
+	Enable  *bool `json:"enable,omitempty"`
+	Port    *int  `json:"port,omitempty"`
+	Timeout *int  `json:"timeout,omitempty"` // Seconds
+}
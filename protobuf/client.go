@@ -0,0 +1,186 @@
+// Package protobuf implements client for Hyperion Protobuf server,
+// it provides color, image and clear operations for deployments where JSON API is not reachable.
+package protobuf
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/denwwer/hyperion-ng/internal/frame"
+	"github.com/denwwer/hyperion-ng/internal/rgb"
+)
+
+// DefaultPort of Hyperion Protobuf server.
+const DefaultPort = 19445
+
+// Validation errors.
+var (
+	ErrPriorityRange = errors.New("priority should be in range 100-199")
+	ErrDuration      = errors.New("duration should be >= 0")
+	ErrImageSize     = errors.New("image data does not match size")
+)
+
+// Config for Protobuf client.
+type Config struct {
+	Host    string `json:"host"`
+	Port    int    `json:"port"`    // Default is 19445
+	Timeout int    `json:"timeout"` // Time to wait for a server response in seconds (default 30 sec)
+}
+
+// Client for Hyperion Protobuf server,
+// connection is established on demand, so it is restored after failures.
+type Client struct {
+	addr    string
+	timeout time.Duration
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+// NewClient creates new client.
+func NewClient(conf Config) *Client {
+	port := conf.Port
+	if port == 0 {
+		port = DefaultPort
+	}
+
+	timeout := time.Second * 30
+	if conf.Timeout > 0 {
+		timeout = time.Second * time.Duration(conf.Timeout)
+	}
+
+	return &Client{
+		addr:    net.JoinHostPort(conf.Host, strconv.Itoa(port)),
+		timeout: timeout,
+	}
+}
+
+// SetColor for all LEDs, duration in milliseconds (nil is endless).
+func (c *Client) SetColor(clr color.Color, priority int, duration *int) error {
+	if err := validate(priority, duration); err != nil {
+		return err
+	}
+
+	return c.send(colorRequest(priority, rgb.Pack(clr), duration))
+}
+
+// SetImage sends image as raw RGB frame, duration in milliseconds (nil is endless).
+func (c *Client) SetImage(img image.Image, priority int, duration *int) error {
+	bounds := img.Bounds()
+	return c.SetRawImage(rgb.FromImage(img), bounds.Dx(), bounds.Dy(), priority, duration)
+}
+
+// SetRawImage sends RGB frame (3 bytes per pixel, row by row), duration in milliseconds (nil is endless).
+func (c *Client) SetRawImage(data []byte, width, height int, priority int, duration *int) error {
+	if err := validate(priority, duration); err != nil {
+		return err
+	}
+
+	if width < 1 || height < 1 || len(data) != width*height*3 {
+		return ErrImageSize
+	}
+
+	return c.send(imageRequest(priority, data, width, height, duration))
+}
+
+// ClearPriority used to revert SetColor or SetImage.
+func (c *Client) ClearPriority(priority int) error {
+	if err := validate(priority, nil); err != nil {
+		return err
+	}
+
+	return c.send(clearRequest(priority))
+}
+
+// ClearAll priorities.
+func (c *Client) ClearAll() error {
+	return c.send(clearAllRequest())
+}
+
+// Close connection.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn == nil {
+		return nil
+	}
+
+	err := c.conn.Close()
+	c.conn = nil
+	return err
+}
+
+func (c *Client) send(req []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn == nil {
+		conn, err := net.DialTimeout("tcp", c.addr, c.timeout)
+		if err != nil {
+			return err
+		}
+
+		c.conn = conn
+	}
+
+	r, err := c.exchange(req)
+	if err != nil {
+		c.conn.Close()
+		c.conn = nil // reconnect on next request
+		return err
+	}
+
+	if !r.Success {
+		return errors.New(r.Error)
+	}
+
+	return nil
+}
+
+// exchange writes size prefixed request and waits for reply,
+// video mode notifications are skipped.
+func (c *Client) exchange(req []byte) (reply, error) {
+	if err := c.conn.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+		return reply{}, err
+	}
+
+	if err := frame.Write(c.conn, req); err != nil {
+		return reply{}, err
+	}
+
+	for {
+		buf, err := frame.Read(c.conn)
+		if err != nil {
+			return reply{}, err
+		}
+
+		r, err := decodeReply(buf)
+		if err != nil {
+			return r, err
+		}
+
+		if r.Type == replyTypeVideo {
+			continue // video mode notification
+		}
+
+		return r, nil
+	}
+}
+
+func validate(priority int, duration *int) error {
+	if priority < 100 || priority > 199 {
+		return ErrPriorityRange
+	}
+
+	if duration != nil && *duration < 0 {
+		return ErrDuration
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package protobuf
+
+import (
+	"image"
+	"image/color"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/denwwer/hyperion-ng/internal/frame"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// testRequest decoded by fake server.
+type testRequest struct {
+	Command  int
+	Priority int
+	Color    int
+	Width    int
+	Height   int
+	Image    []byte
+	Duration *int
+}
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+	c, requests := testClient(t)
+	defer c.Close()
+
+	duration := 500
+	require.Nil(t, c.SetColor(color.RGBA{R: 255, G: 128, B: 1, A: 255}, 150, &duration))
+
+	img := image.NewRGBA(image.Rect(0, 0, 1, 2))
+	img.Set(0, 0, color.RGBA{R: 1, G: 2, B: 3, A: 255})
+	img.Set(0, 1, color.RGBA{R: 4, G: 5, B: 6, A: 255})
+	require.Nil(t, c.SetImage(img, 150, nil))
+
+	require.Nil(t, c.ClearPriority(150))
+	require.Nil(t, c.ClearAll())
+
+	reqs := requests()
+	require.Len(t, reqs, 4)
+	assert.Equal(t, testRequest{Command: commandColor, Priority: 150, Color: 0xFF8001, Duration: &duration}, reqs[0])
+	assert.Equal(t, testRequest{Command: commandImage, Priority: 150, Width: 1, Height: 2, Image: []byte{1, 2, 3, 4, 5, 6}}, reqs[1])
+	assert.Equal(t, testRequest{Command: commandClear, Priority: 150}, reqs[2])
+	assert.Equal(t, testRequest{Command: commandClearAll}, reqs[3])
+}
+
+func TestClientError(t *testing.T) {
+	t.Parallel()
+	c, _ := testClient(t)
+	defer c.Close()
+
+	assert.ErrorIs(t, c.SetColor(color.Black, 20, nil), ErrPriorityRange)
+	assert.ErrorIs(t, c.SetRawImage([]byte{1}, 1, 1, 150, nil), ErrImageSize)
+
+	// server rejects image
+	err := c.SetRawImage([]byte{1, 2, 3}, 1, 1, 199, nil)
+	assert.EqualError(t, err, "image is rejected")
+}
+
+func testClient(t *testing.T) (*Client, func() []testRequest) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	mu := sync.Mutex{}
+	var requests []testRequest
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			buf, err := frame.Read(conn)
+			if err != nil {
+				return
+			}
+
+			req := testDecodeRequest(t, buf)
+
+			mu.Lock()
+			requests = append(requests, req)
+			mu.Unlock()
+
+			// video mode notification before reply
+			frame.Write(conn, testReply(replyTypeVideo, false, ""))
+
+			if req.Command == commandImage && req.Priority == 199 {
+				frame.Write(conn, testReply(replyTypeReply, false, "image is rejected"))
+			} else {
+				frame.Write(conn, testReply(replyTypeReply, true, ""))
+			}
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(l.Addr().String())
+	p, _ := strconv.Atoi(port)
+
+	c := NewClient(Config{Host: host, Port: p})
+
+	return c, func() []testRequest {
+		mu.Lock()
+		defer mu.Unlock()
+		return requests
+	}
+}
+
+// testDecodeRequest decodes HyperionRequest with extension.
+func testDecodeRequest(t *testing.T, b []byte) testRequest {
+	req := testRequest{}
+
+	testFields(t, b, func(num protowire.Number, v uint64, data []byte) {
+		switch num {
+		case 1:
+			req.Command = int(v)
+		case fieldColorRequest, fieldImageRequest, fieldClearRequest:
+			testFields(t, data, func(num protowire.Number, v uint64, data []byte) {
+				switch {
+				case num == 1:
+					req.Priority = int(int32(v))
+				case num == 2 && req.Command == commandColor:
+					req.Color = int(int32(v))
+				case num == 2:
+					req.Width = int(int32(v))
+				case num == 3 && req.Command == commandColor, num == 5:
+					d := int(int32(v))
+					req.Duration = &d
+				case num == 3:
+					req.Height = int(int32(v))
+				case num == 4:
+					req.Image = data
+				}
+			})
+		}
+	})
+
+	return req
+}
+
+func testFields(t *testing.T, b []byte, field func(num protowire.Number, v uint64, data []byte)) {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		require.True(t, n > 0)
+		b = b[n:]
+
+		var v uint64
+		var data []byte
+
+		if typ == protowire.VarintType {
+			v, n = protowire.ConsumeVarint(b)
+		} else {
+			data, n = protowire.ConsumeBytes(b)
+		}
+
+		require.True(t, n > 0)
+		b = b[n:]
+		field(num, v, data)
+	}
+}
+
+func testReply(typ int, success bool, errMsg string) []byte {
+	b := protowire.AppendTag(nil, 1, protowire.VarintType)
+	b = protowire.AppendVarint(b, uint64(typ))
+	b = protowire.AppendTag(b, 2, protowire.VarintType)
+	b = protowire.AppendVarint(b, protowire.EncodeBool(success))
+
+	if errMsg != "" {
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		b = protowire.AppendString(b, errMsg)
+	}
+
+	return b
+}
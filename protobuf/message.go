@@ -0,0 +1,162 @@
+package protobuf
+
+import (
+	"errors"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Messages are encoded by Hyperion schema (proto2):
+//
+//	message HyperionRequest {
+//	  enum Command { COLOR = 1; IMAGE = 2; CLEAR = 3; CLEARALL = 4; }
+//	  required Command command = 1;
+//	  extensions 10 to 100;
+//	}
+//	message ColorRequest {  // extends HyperionRequest with field 10
+//	  required int32 priority = 1;
+//	  required int32 RgbColor = 2;
+//	  optional int32 duration = 3;
+//	}
+//	message ImageRequest {  // extends HyperionRequest with field 11
+//	  required int32 priority = 1;
+//	  required int32 imagewidth = 2;
+//	  required int32 imageheight = 3;
+//	  required bytes imagedata = 4;
+//	  optional int32 duration = 5;
+//	}
+//	message ClearRequest {  // extends HyperionRequest with field 12
+//	  required int32 priority = 1;
+//	}
+//	message HyperionReply {
+//	  enum Type { REPLY = 1; VIDEO = 2; SIGNAL = 3; }
+//	  optional Type type = 1;
+//	  optional bool success = 2;
+//	  optional string error = 3;
+//	  optional int32 video = 4;
+//	}
+
+// Request commands.
+const (
+	commandColor    = 1
+	commandImage    = 2
+	commandClear    = 3
+	commandClearAll = 4
+)
+
+// Request extension fields.
+const (
+	fieldColorRequest protowire.Number = 10
+	fieldImageRequest protowire.Number = 11
+	fieldClearRequest protowire.Number = 12
+)
+
+// Reply types.
+const (
+	replyTypeReply = 1
+	replyTypeVideo = 2
+)
+
+var errMalformedReply = errors.New("malformed reply")
+
+// reply from Hyperion Protobuf server.
+type reply struct {
+	Type    int
+	Success bool
+	Error   string
+	Video   int
+}
+
+func colorRequest(priority int, rgb int32, duration *int) []byte {
+	var msg []byte
+	msg = appendInt32(msg, 1, int32(priority))
+	msg = appendInt32(msg, 2, rgb)
+
+	if duration != nil {
+		msg = appendInt32(msg, 3, int32(*duration))
+	}
+
+	return request(commandColor, fieldColorRequest, msg)
+}
+
+func imageRequest(priority int, data []byte, width, height int, duration *int) []byte {
+	var msg []byte
+	msg = appendInt32(msg, 1, int32(priority))
+	msg = appendInt32(msg, 2, int32(width))
+	msg = appendInt32(msg, 3, int32(height))
+	msg = protowire.AppendTag(msg, 4, protowire.BytesType)
+	msg = protowire.AppendBytes(msg, data)
+
+	if duration != nil {
+		msg = appendInt32(msg, 5, int32(*duration))
+	}
+
+	return request(commandImage, fieldImageRequest, msg)
+}
+
+func clearRequest(priority int) []byte {
+	return request(commandClear, fieldClearRequest, appendInt32(nil, 1, int32(priority)))
+}
+
+func clearAllRequest() []byte {
+	return request(commandClearAll, 0, nil)
+}
+
+// request builds HyperionRequest with optional extension.
+func request(command int32, ext protowire.Number, msg []byte) []byte {
+	req := appendInt32(nil, 1, command)
+
+	if ext != 0 {
+		req = protowire.AppendTag(req, ext, protowire.BytesType)
+		req = protowire.AppendBytes(req, msg)
+	}
+
+	return req
+}
+
+func appendInt32(b []byte, num protowire.Number, v int32) []byte {
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, uint64(int64(v))) // negative int32 is sign extended
+}
+
+func decodeReply(b []byte) (reply, error) {
+	r := reply{}
+
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return r, errMalformedReply
+		}
+		b = b[n:]
+
+		var v uint64
+		var str string
+
+		switch typ {
+		case protowire.VarintType:
+			v, n = protowire.ConsumeVarint(b)
+		case protowire.BytesType:
+			str, n = protowire.ConsumeString(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+
+		if n < 0 {
+			return r, errMalformedReply
+		}
+		b = b[n:]
+
+		switch num {
+		case 1:
+			r.Type = int(v)
+		case 2:
+			r.Success = protowire.DecodeBool(v)
+		case 3:
+			r.Error = str
+		case 4:
+			r.Video = int(int32(v))
+		}
+	}
+
+	return r, nil
+}
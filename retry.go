@@ -0,0 +1,79 @@
+package hyperion
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried.
+// Delay before attempt n+1 is InitialDelay * Multiplier^(n-1), limited by MaxDelay
+// and randomized by Jitter.
+type RetryPolicy struct {
+	MaxAttempts  int                  // Total number of attempts, values < 1 disable retries
+	InitialDelay time.Duration        // Delay before second attempt
+	MaxDelay     time.Duration        // Upper limit of delay, 0 is unlimited
+	Multiplier   float64              // Delay growth factor, values < 1 keep delay constant
+	Jitter       float64              // Randomization factor in range 0-1, delay is changed by up to ±Jitter*delay
+	MaxElapsed   time.Duration        // Stop retrying when this time is elapsed since first attempt, 0 is unlimited
+	Retryable    func(err error) bool // Reports whether request should be retried after error (default DefaultRetryable)
+}
+
+// DefaultRetryPolicy returns policy used by client if not configured:
+// 5 attempts with 5 sec delay.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:  5,
+		InitialDelay: 5 * time.Second,
+		Retryable:    DefaultRetryable,
+	}
+}
+
+// WithRetryPolicy set custom retry policy.
+func WithRetryPolicy(p RetryPolicy) ClientOption {
+	return func(c *Client) {
+		if p.Retryable == nil {
+			p.Retryable = DefaultRetryable
+		}
+
+		c.retry = p
+	}
+}
+
+// delay before next attempt, attempt is number of failed attempts.
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	d := float64(p.InitialDelay)
+
+	if p.Multiplier > 1 {
+		for i := 1; i < attempt; i++ {
+			d *= p.Multiplier
+
+			if p.MaxDelay > 0 && d > float64(p.MaxDelay) {
+				break
+			}
+		}
+	}
+
+	if p.MaxDelay > 0 && d > float64(p.MaxDelay) {
+		d = float64(p.MaxDelay)
+	}
+
+	if p.Jitter > 0 {
+		d += d * p.Jitter * (rand.Float64()*2 - 1)
+	}
+
+	return time.Duration(d)
+}
+
+// next returns delay before next attempt and reports whether it should be done.
+func (p RetryPolicy) next(attempt int, start time.Time, err error) (time.Duration, bool) {
+	if attempt >= p.MaxAttempts || !p.Retryable(err) {
+		return 0, false
+	}
+
+	d := p.delay(attempt)
+	if p.MaxElapsed > 0 && time.Since(start)+d > p.MaxElapsed {
+		return 0, false
+	}
+
+	return d, true
+}
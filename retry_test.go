@@ -0,0 +1,126 @@
+package hyperion
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicyDelay(t *testing.T) {
+	t.Parallel()
+
+	p := RetryPolicy{InitialDelay: 100 * time.Millisecond, MaxDelay: 500 * time.Millisecond, Multiplier: 2}
+
+	var delays []time.Duration
+	for i := 1; i <= 5; i++ {
+		delays = append(delays, p.delay(i))
+	}
+
+	assert.Equal(t, []time.Duration{100, 200, 400, 500, 500}, scale(delays, time.Millisecond))
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		d := p.delay(1)
+		assert.GreaterOrEqual(t, d, 50*time.Millisecond)
+		assert.LessOrEqual(t, d, 150*time.Millisecond)
+	}
+}
+
+func TestRetryPolicyNext(t *testing.T) {
+	t.Parallel()
+
+	p := RetryPolicy{MaxAttempts: 3, InitialDelay: time.Second, Retryable: DefaultRetryable}
+	err := errors.New("connection refused")
+
+	_, ok := p.next(1, time.Now(), err)
+	assert.True(t, ok)
+
+	_, ok = p.next(3, time.Now(), err)
+	assert.False(t, ok, "attempts are exhausted")
+
+	_, ok = p.next(1, time.Now(), context.Canceled)
+	assert.False(t, ok, "canceled is not retryable")
+
+	_, ok = p.next(1, time.Now(), &DeliveredError{Err: errConnectionLost})
+	assert.False(t, ok, "delivered request is not retryable")
+
+	p.MaxElapsed = 1500 * time.Millisecond
+	_, ok = p.next(1, time.Now().Add(-time.Second), err)
+	assert.False(t, ok, "elapsed time is exceeded")
+}
+
+func TestRetryRequestReplay(t *testing.T) {
+	t.Parallel()
+
+	mu := sync.Mutex{}
+	var bodies []string
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+
+		mu.Lock()
+		bodies = append(bodies, string(b))
+		first := len(bodies) == 1
+		mu.Unlock()
+
+		if first {
+			// drop connection without response
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+			return
+		}
+
+		w.Write([]byte(`{"success":true}`))
+	}))
+	defer s.Close()
+
+	u, _ := url.Parse(s.URL)
+	port, _ := strconv.Atoi(u.Port())
+
+	retryable := 0
+	c := NewClient(Config{Connection: Connection{Type: ConnectHTTP, Host: u.Hostname(), Port: port}}, WithRetryPolicy(RetryPolicy{
+		MaxAttempts:  3,
+		InitialDelay: 10 * time.Millisecond,
+		Retryable: func(err error) bool {
+			retryable++
+			return DefaultRetryable(err)
+		},
+	}))
+
+	require.Nil(t, c.SetColor([]int{255, 0, 0}, 20, "test 1", nil))
+	require.Len(t, bodies, 2)
+	assert.NotEmpty(t, bodies[1])
+	assert.Equal(t, bodies[0], bodies[1])
+	assert.Equal(t, 1, retryable)
+}
+
+func TestRetryDisabled(t *testing.T) {
+	t.Parallel()
+
+	attempts := 0
+	c := NewClient(Config{}, WithRetryPolicy(RetryPolicy{}), WithTransport(TransportFunc(func(ctx context.Context, req []byte) ([]byte, error) {
+		attempts++
+		return nil, errors.New("connection refused")
+	})))
+
+	assert.Error(t, c.SetSourceAuto())
+	assert.Equal(t, 1, attempts)
+}
+
+func scale(delays []time.Duration, unit time.Duration) []time.Duration {
+	res := make([]time.Duration, len(delays))
+	for i, d := range delays {
+		res[i] = d / unit
+	}
+	return res
+}
@@ -1,6 +1,8 @@
 package hyperion
 
 import (
+	"context"
+
 	m "github.com/denwwer/hyperion-ng/internal/model"
 	"github.com/denwwer/hyperion-ng/model"
 )
@@ -9,8 +11,12 @@ const cmdServerInfo = "serverinfo"
 
 // ServerInfo retrieve live state of Hyperion.
 func (c *Client) ServerInfo() (*model.Information, error) {
-	tan := 1
-	req := m.Request{Command: cmdServerInfo, Tan: &tan}
+	return c.ServerInfoContext(context.Background())
+}
+
+// ServerInfoContext same as ServerInfo with context.
+func (c *Client) ServerInfoContext(ctx context.Context) (*model.Information, error) {
+	req := m.Request{Command: cmdServerInfo}
 	resp := &model.Information{}
-	return resp, c.send(req, &resp)
+	return resp, c.send(ctx, req, &resp)
 }
@@ -0,0 +1,38 @@
+package hyperion
+
+import (
+	"context"
+
+	m "github.com/denwwer/hyperion-ng/internal/model"
+	"github.com/denwwer/hyperion-ng/model"
+)
+
+const cmdService = "service"
+
+const serviceDiscover = "discover"
+
+// ErrServiceTypeRequired is returned when service type is empty.
+var ErrServiceTypeRequired error = validationError("service type is required")
+
+// DiscoverServices of Hyperion servers on the network, e.g. model.ServiceJSONAPI.
+func (c Client) DiscoverServices(serviceType string) (*model.ServiceDiscovery, error) {
+	return c.DiscoverServicesContext(context.Background(), serviceType)
+}
+
+// DiscoverServicesContext same as DiscoverServices with context.
+func (c Client) DiscoverServicesContext(ctx context.Context, serviceType string) (*model.ServiceDiscovery, error) {
+	if serviceType == "" {
+		return nil, ErrServiceTypeRequired
+	}
+
+	req := struct {
+		m.Request
+		Type string `json:"serviceType"`
+	}{
+		Request: m.Request{Command: cmdService, Subcommand: serviceDiscover},
+		Type:    serviceType,
+	}
+
+	resp := &model.ServiceDiscovery{}
+	return resp, c.send(ctx, req, &resp)
+}
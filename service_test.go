@@ -0,0 +1,42 @@
+package hyperion
+
+import (
+	"testing"
+
+	"github.com/denwwer/hyperion-ng/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscoverServices(t *testing.T) {
+	t.Parallel()
+	c := testClient()
+
+	found, err := c.DiscoverServices(model.ServiceJSONAPI)
+	require.Nil(t, err)
+	require.Len(t, found.Services, 1)
+	assert.Equal(t, model.Session{
+		Name:    "Bedroom",
+		Host:    "hyperion-bedroom.local",
+		Address: "192.168.53.131",
+		Port:    19444,
+		Type:    "_hyperiond-json._tcp",
+		Domain:  "local.",
+	}, found.Services[0].Session())
+
+	_, err = c.DiscoverServices("")
+	assert.ErrorIs(t, err, ErrServiceTypeRequired)
+}
+
+func TestSessions(t *testing.T) {
+	t.Parallel()
+	c := testClient()
+
+	info, err := c.ServerInfo()
+	require.Nil(t, err)
+	require.Len(t, info.Sessions, 1)
+	assert.Equal(t, "hyperion-bedroom", info.Sessions[0].Host)
+	assert.Equal(t, 8090, info.Sessions[0].Port)
+	assert.Equal(t, "_hyperiond-http._tcp.", info.Sessions[0].Type)
+}
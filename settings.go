@@ -0,0 +1,148 @@
+package hyperion
+
+import (
+	"context"
+	"encoding/json"
+
+	m "github.com/denwwer/hyperion-ng/internal/model"
+	"github.com/denwwer/hyperion-ng/model"
+)
+
+const cmdConfig = "config"
+
+const (
+	configGet     = "getconfig"
+	configSet     = "setconfig"
+	configSchema  = "getschema"
+	configRestore = "restoreconfig"
+	configReload  = "reload"
+)
+
+// GetConfig retrieve persistent settings of Hyperion.
+func (c *Client) GetConfig() (*model.Settings, error) {
+	return c.GetConfigContext(context.Background())
+}
+
+// GetConfigContext same as GetConfig with context.
+func (c *Client) GetConfigContext(ctx context.Context) (*model.Settings, error) {
+	req := m.Request{Command: cmdConfig, Subcommand: configGet}
+	resp := &model.Settings{}
+	return resp, c.send(ctx, req, &resp)
+}
+
+// SetConfig updates only given sections and fields, they are merged into current settings.
+// JSON null in Settings.Raw removes the field.
+func (c *Client) SetConfig(settings model.Settings) error {
+	return c.SetConfigContext(context.Background(), settings)
+}
+
+// SetConfigContext same as SetConfig with context.
+func (c *Client) SetConfigContext(ctx context.Context, settings model.Settings) error {
+	patch, err := sections(settings)
+	if err != nil {
+		return err
+	}
+
+	current, err := c.GetConfigContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	config := map[string]json.RawMessage{}
+	for name, section := range patch {
+		if config[name], err = mergePatch(current.Raw[name], section); err != nil {
+			return err
+		}
+	}
+
+	req := struct {
+		m.Request
+		Config map[string]json.RawMessage `json:"config"`
+	}{
+		Request: m.Request{Command: cmdConfig, Subcommand: configSet},
+		Config:  config,
+	}
+
+	return c.send(ctx, req, nil)
+}
+
+// GetSchema retrieve JSON schema of settings.
+func (c *Client) GetSchema() (json.RawMessage, error) {
+	return c.GetSchemaContext(context.Background())
+}
+
+// GetSchemaContext same as GetSchema with context.
+func (c *Client) GetSchemaContext(ctx context.Context) (json.RawMessage, error) {
+	req := m.Request{Command: cmdConfig, Subcommand: configSchema}
+	var resp json.RawMessage
+	return resp, c.send(ctx, req, &resp)
+}
+
+// RestoreConfig replaces all settings, e.g. from backup.
+func (c *Client) RestoreConfig(settings model.Settings) error {
+	return c.RestoreConfigContext(context.Background(), settings)
+}
+
+// RestoreConfigContext same as RestoreConfig with context.
+func (c *Client) RestoreConfigContext(ctx context.Context, settings model.Settings) error {
+	req := struct {
+		m.Request
+		Config model.Settings `json:"config"`
+	}{
+		Request: m.Request{Command: cmdConfig, Subcommand: configRestore},
+		Config:  settings,
+	}
+
+	return c.send(ctx, req, nil)
+}
+
+// Reload Hyperion to apply settings.
+func (c *Client) Reload() error {
+	return c.ReloadContext(context.Background())
+}
+
+// ReloadContext same as Reload with context.
+func (c *Client) ReloadContext(ctx context.Context) error {
+	req := m.Request{Command: cmdConfig, Subcommand: configReload}
+	return c.send(ctx, req, nil)
+}
+
+// sections of encoded settings by name.
+func sections(settings model.Settings) (map[string]json.RawMessage, error) {
+	b, err := json.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]json.RawMessage{}
+	return data, json.Unmarshal(b, &data)
+}
+
+// mergePatch applies JSON merge patch (RFC 7386) to target.
+func mergePatch(target, patch json.RawMessage) (json.RawMessage, error) {
+	patchObj := map[string]json.RawMessage{}
+	if json.Unmarshal(patch, &patchObj) != nil {
+		return patch, nil // not an object, replaced as is
+	}
+
+	targetObj := map[string]json.RawMessage{}
+	if json.Unmarshal(target, &targetObj) != nil || targetObj == nil {
+		targetObj = map[string]json.RawMessage{}
+	}
+
+	for key, val := range patchObj {
+		if string(val) == "null" {
+			delete(targetObj, key)
+			continue
+		}
+
+		merged, err := mergePatch(targetObj[key], val)
+		if err != nil {
+			return nil, err
+		}
+
+		targetObj[key] = merged
+	}
+
+	return json.Marshal(targetObj)
+}
@@ -0,0 +1,83 @@
+package hyperion
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/denwwer/hyperion-ng/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetConfig(t *testing.T) {
+	t.Parallel()
+	c := testClient()
+
+	settings, err := c.GetConfig()
+	require.Nil(t, err)
+
+	require.NotNil(t, settings.Device)
+	assert.Equal(t, "ws2812spi", *settings.Device.Type)
+	assert.Equal(t, 60, *settings.Device.HardwareLedCount)
+	assert.Equal(t, 150, *settings.Smoothing.Time)
+	assert.Equal(t, []string{"127.0.0.1:19446"}, settings.Forwarder.JSON)
+	assert.Equal(t, 19400, *settings.FlatbufServer.Port)
+	assert.Nil(t, settings.GrabberV4L2)
+	assert.JSONEq(t, `{"name":"My Hyperion Config","configVersion":"configVersion2"}`, string(settings.Raw["general"]))
+
+	schema, err := c.GetSchema()
+	require.Nil(t, err)
+	assert.Contains(t, string(schema), "time_ms")
+
+	require.Nil(t, c.Reload())
+}
+
+func TestSetConfig(t *testing.T) {
+	t.Parallel()
+
+	c, requests := testMemClient(nil)
+
+	timeMs := 300
+	err := c.SetConfig(model.Settings{
+		Smoothing: &model.SmoothingSettings{Time: &timeMs},
+		Raw: map[string]json.RawMessage{
+			"device":  json.RawMessage(`{"invert":null}`),
+			"general": json.RawMessage(`{"name":"Living room"}`),
+		},
+	})
+	require.Nil(t, err)
+
+	config := map[string]json.RawMessage{}
+	for _, req := range requests.all() {
+		if req["subcommand"] == "setconfig" {
+			for section, value := range req["config"].(map[string]interface{}) {
+				config[section], _ = json.Marshal(value)
+			}
+		}
+	}
+
+	// only given sections are sent, unchanged fields are kept
+	require.Len(t, config, 3)
+	assert.JSONEq(t, `{"enable":true,"type":"linear","time_ms":300,"updateFrequency":25,"interpolationRate":1,"decay":1,"dithering":false,"updateDelay":0}`, string(config["smoothing"]))
+	assert.NotContains(t, string(config["device"]), "invert")
+	assert.Contains(t, string(config["device"]), `"hardwareLedCount":60`)
+	assert.JSONEq(t, `{"name":"Living room","configVersion":"configVersion2"}`, string(config["general"]))
+}
+
+func TestSettingsJSON(t *testing.T) {
+	t.Parallel()
+
+	enable := false
+	settings := model.Settings{
+		Smoothing: &model.SmoothingSettings{Enable: &enable},
+		Raw: map[string]json.RawMessage{
+			"smoothing": json.RawMessage(`{"enable":true}`),
+			"effects":   json.RawMessage(`{"paths":["$ROOT/custom-effects"]}`),
+		},
+	}
+
+	b, err := json.Marshal(settings)
+	require.Nil(t, err)
+	assert.JSONEq(t, `{"smoothing":{"enable":false},"effects":{"paths":["$ROOT/custom-effects"]}}`, string(b))
+}
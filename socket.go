@@ -0,0 +1,413 @@
+package hyperion
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	m "github.com/denwwer/hyperion-ng/internal/model"
+)
+
+var (
+	errConnectionLost = errors.New("connection to Hyperion is lost")
+	errRequestTimeout = errors.New("timeout waiting for Hyperion response")
+)
+
+// frameConn reads and writes whole JSON messages over established connection.
+type frameConn interface {
+	writeMessage(msg []byte) error
+	readMessage() ([]byte, error)
+	SetWriteDeadline(t time.Time) error
+	Close() error
+}
+
+// socketTransport is a persistent connection to Hyperion (TCP or WebSocket) shared by concurrent requests,
+// every request gets unique tan and response is routed back to its caller by tan.
+// Connection is (re)established and authorized on demand.
+type socketTransport struct {
+	dial    func(timeout time.Duration) (frameConn, error)
+	timeout time.Duration
+	token   *credentials
+	logger  Logger
+	verbose bool
+	push    func(msg []byte) // handles messages pushed by server
+
+	tan    atomic.Int64
+	mu     sync.Mutex
+	conn   *socketConn
+	replay [][]byte       // requests replayed on reconnect
+	refs   map[string]int // consumers of replayed requests
+	keepMu sync.Mutex     // keeps start and stop of shared requests in order
+
+	reconnectDelay time.Duration // first delay of background reconnect
+	reconnecting   bool          // background reconnect is running
+}
+
+// socketConn is single established connection with its in-flight requests.
+type socketConn struct {
+	frame   frameConn
+	wmu     sync.Mutex
+	mu      sync.Mutex
+	pending map[int]chan []byte
+	done    chan struct{} // closed when connection is lost
+}
+
+// RoundTrip sends request with unique tan and waits for response with the same tan.
+func (s *socketTransport) RoundTrip(ctx context.Context, req []byte) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	conn, reused, err := s.connection()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, sent, err := s.exchange(ctx, conn, req)
+	if err != nil && !sent && reused {
+		// connection could be closed by server while idle, reconnect once
+		if conn, _, err = s.connection(); err != nil {
+			return nil, err
+		}
+
+		resp, _, err = s.exchange(ctx, conn, req)
+	}
+
+	return resp, err
+}
+
+// connection returns established connection or dials new one.
+func (s *socketTransport) connection() (*socketConn, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn != nil {
+		select {
+		case <-s.conn.done:
+			s.conn = nil // lost, dial new one
+		default:
+			return s.conn, true, nil
+		}
+	}
+
+	frame, err := s.dial(s.timeout)
+	if err != nil {
+		return nil, false, err
+	}
+
+	conn := &socketConn{
+		frame:   frame,
+		pending: map[int]chan []byte{},
+		done:    make(chan struct{}),
+	}
+	go s.read(conn)
+
+	if err = s.login(conn); err != nil {
+		s.drop(conn)
+		return nil, false, err
+	}
+
+	if err = s.resubscribe(conn); err != nil {
+		s.logger.Warn("could not resubscribe to Hyperion events: " + err.Error())
+	}
+
+	s.conn = conn
+	return conn, false, nil
+}
+
+// login authorize connection once, session is kept by server.
+func (s *socketTransport) login(conn *socketConn) error {
+	token := s.token.get()
+	if token == "" {
+		return nil
+	}
+
+	login, _ := json.Marshal(struct {
+		m.Request
+		Token string `json:"token"`
+	}{
+		Request: m.Request{Command: cmdAuthorize, Subcommand: authLogin},
+		Token:   token,
+	})
+
+	resp, _, err := s.exchange(context.Background(), conn, login)
+	if err != nil {
+		return err
+	}
+
+	respData := m.Response{}
+	if err = json.Unmarshal(resp, &respData); err != nil {
+		return err
+	}
+
+	if !respData.Success {
+		return &APIError{
+			Command:  respData.Command,
+			Tan:      respData.Tan,
+			Instance: respData.Instance,
+			Message:  respData.Error,
+			Err:      ErrAuthRequired,
+		}
+	}
+
+	return nil
+}
+
+// keep request to replay it on reconnect, e.g. subscription, every consumer keeps it once.
+func (s *socketTransport) keep(req []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.refs == nil {
+		s.refs = map[string]int{}
+	}
+
+	if s.refs[string(req)] == 0 {
+		s.replay = append(s.replay, req)
+	}
+
+	s.refs[string(req)]++
+}
+
+// kept reports whether request is kept for replay.
+func (s *socketTransport) kept(req []byte) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.ContainsFunc(s.replay, func(r []byte) bool {
+		return bytes.Equal(r, req)
+	})
+}
+
+// forget request kept by consumer, reports true when the last consumer is gone.
+func (s *socketTransport) forget(req []byte) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.refs[string(req)] == 0 {
+		return false // forgotten by Close
+	}
+
+	s.refs[string(req)]--
+	if s.refs[string(req)] > 0 {
+		return false
+	}
+
+	delete(s.refs, string(req))
+	if i := slices.IndexFunc(s.replay, func(r []byte) bool { return bytes.Equal(r, req) }); i >= 0 {
+		s.replay = slices.Delete(s.replay, i, i+1)
+	}
+
+	return true
+}
+
+// resubscribe replays kept requests on new connection, caller holds s.mu.
+func (s *socketTransport) resubscribe(conn *socketConn) error {
+	for _, req := range s.replay {
+		if _, _, err := s.exchange(context.Background(), conn, req); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconnect in background while there are subscriptions, so events keep coming without requests,
+// only one reconnect is running at time.
+func (s *socketTransport) reconnect() {
+	for delay := s.reconnectDelay; ; delay = min(2*delay, time.Minute) {
+		time.Sleep(delay)
+
+		if s.reconnected() {
+			return
+		}
+
+		if _, _, err := s.connection(); err == nil && s.reconnected() {
+			return
+		}
+	}
+}
+
+// reconnected reports whether reconnect is done, because connection is alive or nothing to restore.
+func (s *socketTransport) reconnected() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	alive := false
+	if s.conn != nil {
+		select {
+		case <-s.conn.done:
+		default:
+			alive = true
+		}
+	}
+
+	if alive || len(s.replay) == 0 {
+		s.reconnecting = false
+		return true
+	}
+
+	return false
+}
+
+// exchange writes request and waits for its response,
+// sent reports whether request was written to connection.
+func (s *socketTransport) exchange(ctx context.Context, conn *socketConn, req []byte) ([]byte, bool, error) {
+	tan := int(s.tan.Add(1))
+
+	req, err := setTan(req, tan)
+	if err != nil {
+		return nil, false, err
+	}
+
+	ch := make(chan []byte, 1)
+
+	conn.mu.Lock()
+	conn.pending[tan] = ch
+	conn.mu.Unlock()
+
+	defer func() {
+		conn.mu.Lock()
+		delete(conn.pending, tan)
+		conn.mu.Unlock()
+	}()
+
+	s.log(">>>\n" + string(req) + "\n")
+
+	conn.wmu.Lock()
+	conn.frame.SetWriteDeadline(time.Now().Add(s.timeout))
+	err = conn.frame.writeMessage(req)
+	conn.wmu.Unlock()
+
+	if err != nil {
+		s.drop(conn)
+		return nil, false, err
+	}
+
+	// deadline of context takes precedence over connection timeout,
+	// so long-running commands could wait for server response
+	var timeout <-chan time.Time
+	if _, ok := ctx.Deadline(); !ok {
+		timer := time.NewTimer(s.timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case resp := <-ch:
+		return resp, true, nil
+	case <-conn.done:
+		return nil, true, &DeliveredError{Err: errConnectionLost}
+	case <-timeout:
+		return nil, true, &DeliveredError{Err: errRequestTimeout}
+	case <-ctx.Done():
+		return nil, true, &DeliveredError{Err: ctx.Err()}
+	}
+}
+
+// read routes incoming messages to waiting requests until connection is lost.
+func (s *socketTransport) read(conn *socketConn) {
+	for {
+		msg, err := conn.frame.readMessage()
+		if err != nil {
+			s.drop(conn)
+
+			// connection which is set up or already replaced is handled by its owner
+			s.mu.Lock()
+			start := s.conn == conn && len(s.replay) > 0 && !s.reconnecting
+			if start {
+				s.reconnecting = true
+			}
+			s.mu.Unlock()
+
+			if start {
+				go s.reconnect()
+			}
+
+			return
+		}
+
+		s.log("<<<\n" + string(msg))
+
+		head := struct {
+			Command string `json:"command"`
+			Tan     *int   `json:"tan"`
+		}{}
+
+		if err = json.Unmarshal(msg, &head); err != nil {
+			s.logger.Warn("could not decode Hyperion message: " + err.Error())
+			continue
+		}
+
+		if strings.HasSuffix(head.Command, "-update") {
+			if s.push != nil {
+				s.push(msg)
+			}
+			continue
+		}
+
+		if head.Tan == nil {
+			continue // not a response
+		}
+
+		conn.mu.Lock()
+		ch, ok := conn.pending[*head.Tan]
+		delete(conn.pending, *head.Tan)
+		conn.mu.Unlock()
+
+		if ok {
+			ch <- msg
+		}
+	}
+}
+
+// drop closes connection and fails its in-flight requests.
+func (s *socketTransport) drop(conn *socketConn) {
+	conn.mu.Lock()
+	select {
+	case <-conn.done:
+	default:
+		close(conn.done)
+		conn.frame.Close()
+	}
+	conn.mu.Unlock()
+}
+
+// Close connection and forget subscriptions, next request will reconnect.
+func (s *socketTransport) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.replay = nil
+	s.refs = nil
+
+	if s.conn != nil {
+		s.drop(s.conn)
+		s.conn = nil
+	}
+
+	return nil
+}
+
+func (s *socketTransport) log(msg string) {
+	if s.verbose {
+		s.logger.Info(msg)
+	}
+}
+
+// setTan replaces tan of encoded request.
+func setTan(req []byte, tan int) ([]byte, error) {
+	data := map[string]json.RawMessage{}
+	if err := json.Unmarshal(req, &data); err != nil {
+		return nil, err
+	}
+
+	data["tan"], _ = json.Marshal(tan)
+	return json.Marshal(data)
+}
@@ -0,0 +1,169 @@
+package hyperion
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSocketConcurrent(t *testing.T) {
+	t.Parallel()
+
+	for name, c := range map[string]*Client{"tcp": testTCPClient(), "ws": testWSClient()} {
+		t.Run(name, func(t *testing.T) {
+			defer c.Close()
+			wg := sync.WaitGroup{}
+
+			for i := 0; i < 20; i++ {
+				wg.Add(2)
+
+				go func() {
+					defer wg.Done()
+					info, err := c.ServerInfo()
+					assert.Nil(t, err)
+					assert.NotEmpty(t, info.Components)
+				}()
+
+				go func() {
+					defer wg.Done()
+					sys, err := c.SystemInfo()
+					assert.Nil(t, err)
+					assert.NotEmpty(t, sys.Hyperion.Version)
+				}()
+			}
+
+			wg.Wait()
+		})
+	}
+}
+
+func TestSocketTimeout(t *testing.T) {
+	t.Parallel()
+
+	// server reads requests but never responds
+	l := testSilentServer(t, false)
+	s := &socketTransport{dial: dialTCP(l.Addr().String()), timeout: 100 * time.Millisecond, token: &credentials{}, logger: &StdLogger{}}
+	defer s.Close()
+
+	_, err := s.RoundTrip(context.Background(), []byte(`{"command":"serverinfo"}`))
+	assert.ErrorIs(t, err, errRequestTimeout)
+	assert.False(t, DefaultRetryable(err), "request is delivered")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = s.RoundTrip(ctx, []byte(`{"command":"serverinfo"}`))
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestSocketConnectionLost(t *testing.T) {
+	t.Parallel()
+
+	// server closes connection after first request
+	l := testSilentServer(t, true)
+	s := &socketTransport{dial: dialTCP(l.Addr().String()), timeout: time.Second, token: &credentials{}, logger: &StdLogger{}}
+	defer s.Close()
+
+	_, err := s.RoundTrip(context.Background(), []byte(`{"command":"serverinfo"}`))
+	assert.ErrorIs(t, err, errConnectionLost)
+}
+
+func TestSocketReconnectOnce(t *testing.T) {
+	t.Parallel()
+
+	// server accepts first login only and drops first connection
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	defer l.Close()
+
+	var connections atomic.Int32
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+
+			first := connections.Add(1) == 1
+			go func() {
+				defer conn.Close()
+				scanner := bufio.NewScanner(conn)
+
+				for scanner.Scan() {
+					req := map[string]interface{}{}
+					_ = json.Unmarshal(scanner.Bytes(), &req)
+
+					resp, _ := json.Marshal(map[string]interface{}{"command": req["command"], "tan": req["tan"], "success": first})
+					conn.Write(append(resp, '\n'))
+
+					if first && req["command"] == "serverinfo" {
+						time.Sleep(50 * time.Millisecond)
+						return
+					}
+				}
+			}()
+		}
+	}()
+
+	s := &socketTransport{
+		dial:           dialTCP(l.Addr().String()),
+		timeout:        time.Second,
+		reconnectDelay: 10 * time.Millisecond,
+		token:          &credentials{token: "token"},
+		logger:         &StdLogger{},
+	}
+	defer s.Close()
+
+	req := []byte(`{"command":"serverinfo","subscribe":["components-update"]}`)
+	_, err = s.RoundTrip(context.Background(), req)
+	require.Nil(t, err)
+	s.keep(req)
+
+	// backoff of single reconnect: 10, 20, 40, 80, 160, 320 ms
+	time.Sleep(700 * time.Millisecond)
+	assert.LessOrEqual(t, connections.Load(), int32(8))
+}
+
+func TestSetTan(t *testing.T) {
+	t.Parallel()
+
+	req, err := setTan([]byte(`{"command":"serverinfo","tan":1}`), 42)
+	require.Nil(t, err)
+	assert.JSONEq(t, `{"command":"serverinfo","tan":42}`, string(req))
+}
+
+func testSilentServer(t *testing.T, hangup bool) net.Listener {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close()
+				r := bufio.NewReader(conn)
+
+				for {
+					if _, err := r.ReadBytes('\n'); err != nil || hangup {
+						return
+					}
+				}
+			}()
+		}
+	}()
+
+	return l
+}
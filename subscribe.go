@@ -0,0 +1,162 @@
+package hyperion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"sync"
+
+	m "github.com/denwwer/hyperion-ng/internal/model"
+	"github.com/denwwer/hyperion-ng/model"
+)
+
+// eventBuffer size of channel returned by Events.
+const eventBuffer = 64
+
+// Subscription errors.
+var (
+	ErrEventsRequired         error = validationError("at least one event is required")
+	ErrSubscriptionNotAllowed       = errors.New("subscription requires TCP or WebSocket connection")
+)
+
+// EventHandler is called for every subscribed event from connection reader,
+// so it should not block.
+type EventHandler func(event model.Event)
+
+// Subscribe handler to events pushed by Hyperion, subscriptions are restored on reconnect
+// and removed by Close.
+func (c *Client) Subscribe(handler EventHandler, events ...model.EventType) error {
+	return c.SubscribeContext(context.Background(), handler, events...)
+}
+
+// SubscribeContext same as Subscribe with context.
+func (c *Client) SubscribeContext(ctx context.Context, handler EventHandler, events ...model.EventType) error {
+	_, _, err := c.subscribe(ctx, handler, events)
+	return err
+}
+
+// Events returns channel of events pushed by Hyperion, channel is closed when ctx is done
+// and subscription is not restored on reconnect anymore. Events are dropped if channel is full.
+func (c *Client) Events(ctx context.Context, events ...model.EventType) (<-chan model.Event, error) {
+	ch := make(chan model.Event, eventBuffer)
+	mu := sync.Mutex{}
+	closed := false
+
+	id, req, err := c.subscribe(ctx, func(event model.Event) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if closed {
+			return
+		}
+
+		select {
+		case ch <- event:
+		default:
+			c.logger.Warn("event channel is full, dropped " + string(event.Type))
+		}
+	}, events)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		<-ctx.Done()
+		c.events.remove(id)
+		c.transport.(*socketTransport).forget(req)
+
+		mu.Lock()
+		closed = true
+		close(ch)
+		mu.Unlock()
+	}()
+
+	return ch, nil
+}
+
+// subscribe handler and returns its id with request kept for replay.
+func (c *Client) subscribe(ctx context.Context, handler EventHandler, events []model.EventType) (int, []byte, error) {
+	if len(events) == 0 {
+		return 0, nil, ErrEventsRequired
+	}
+
+	socket, ok := c.transport.(*socketTransport)
+	if !ok {
+		return 0, nil, ErrSubscriptionNotAllowed
+	}
+
+	// handler is added first, so events pushed right after response are not lost
+	id := c.events.add(handler, events)
+
+	req, _ := json.Marshal(struct {
+		m.Request
+		Subscribe []model.EventType `json:"subscribe"`
+	}{
+		Request:   m.Request{Command: cmdServerInfo},
+		Subscribe: events,
+	})
+	req = c.bind(cmdServerInfo, req)
+
+	if err := c.send(ctx, json.RawMessage(req), nil); err != nil {
+		c.events.remove(id)
+		return 0, nil, err
+	}
+
+	socket.keep(req)
+	return id, req, nil
+}
+
+// eventHub dispatches pushed messages to subscribed handlers.
+type eventHub struct {
+	mu       sync.Mutex
+	next     int
+	handlers map[int]eventSubscription
+	logger   Logger
+}
+
+type eventSubscription struct {
+	events  []model.EventType
+	handler EventHandler
+}
+
+func (h *eventHub) add(handler EventHandler, events []model.EventType) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.handlers == nil {
+		h.handlers = map[int]eventSubscription{}
+	}
+
+	h.next++
+	h.handlers[h.next] = eventSubscription{events: events, handler: handler}
+	return h.next
+}
+
+func (h *eventHub) remove(id int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.handlers, id)
+}
+
+// dispatch decodes pushed message and calls handlers subscribed to its type.
+func (h *eventHub) dispatch(msg []byte) {
+	event := model.Event{}
+	if err := json.Unmarshal(msg, &event); err != nil {
+		h.logger.Warn("could not decode Hyperion event: " + err.Error())
+		return
+	}
+
+	h.mu.Lock()
+	var handlers []EventHandler
+	for _, sub := range h.handlers {
+		if slices.Contains(sub.events, event.Type) {
+			handlers = append(handlers, sub.handler)
+		}
+	}
+	h.mu.Unlock()
+
+	for _, handler := range handlers {
+		handler(event)
+	}
+}
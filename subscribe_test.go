@@ -0,0 +1,124 @@
+package hyperion
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/denwwer/hyperion-ng/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvents(t *testing.T) {
+	t.Parallel()
+
+	for name, c := range map[string]*Client{"tcp": testTCPClient(), "ws": testWSClient()} {
+		t.Run(name, func(t *testing.T) {
+			defer c.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			events, err := c.Events(ctx, model.EventComponents, model.EventPriorities, model.EventVideoMode, model.EventInstance, model.EventAdjustment)
+			require.Nil(t, err)
+
+			received := map[model.EventType]model.Event{}
+			for len(received) < 5 {
+				event, ok := <-events
+				require.True(t, ok, "channel closed before all events are received")
+				received[event.Type] = event
+			}
+
+			assert.Equal(t, &model.Component{Name: "SMOOTHING", Enabled: false}, received[model.EventComponents].Component)
+
+			priorities := received[model.EventPriorities]
+			require.Len(t, priorities.Priorities, 1)
+			assert.Equal(t, []int{255, 0, 0}, priorities.Priorities[0].Value.RGB)
+			assert.True(t, priorities.PrioritiesAutoselect)
+
+			assert.Equal(t, "3DSBS", received[model.EventVideoMode].VideoMode)
+			assert.Equal(t, "Desk", received[model.EventInstance].Instances.Find(1).Name)
+			assert.Equal(t, 80, *received[model.EventAdjustment].Adjustments[0].Brightness)
+
+			cancel()
+			for range events {
+				// drained until closed
+			}
+		})
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	t.Parallel()
+	c := testTCPClient()
+	defer c.Close()
+
+	events := make(chan model.Event, 10)
+	err := c.Subscribe(func(event model.Event) {
+		events <- event
+	}, model.EventVideoMode)
+	require.Nil(t, err)
+
+	event := <-events
+	assert.Equal(t, model.EventVideoMode, event.Type)
+
+	// connection is lost, subscription is restored in background
+	s := c.transport.(*socketTransport)
+	s.mu.Lock()
+	s.conn.frame.Close()
+	s.mu.Unlock()
+
+	select {
+	case event = <-events:
+		assert.Equal(t, "3DSBS", event.VideoMode)
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription is not restored")
+	}
+}
+
+func TestEventsForget(t *testing.T) {
+	t.Parallel()
+	c := testTCPClient()
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	firstCtx, cancelFirst := context.WithCancel(ctx)
+	first, err := c.Events(firstCtx, model.EventVideoMode)
+	require.Nil(t, err)
+
+	second, err := c.Events(ctx, model.EventVideoMode)
+	require.Nil(t, err)
+
+	s := c.transport.(*socketTransport)
+	kept := func() int {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return len(s.replay)
+	}
+	assert.Equal(t, 1, kept())
+
+	// subscription is shared until the last channel is closed
+	cancelFirst()
+	for range first {
+	}
+	assert.Equal(t, 1, kept())
+
+	cancel()
+	for range second {
+	}
+	assert.Equal(t, 0, kept())
+}
+
+func TestSubscribeError(t *testing.T) {
+	t.Parallel()
+
+	err := testClient().Subscribe(func(event model.Event) {}, model.EventComponents)
+	assert.ErrorIs(t, err, ErrSubscriptionNotAllowed)
+
+	err = testTCPClient().Subscribe(func(event model.Event) {})
+	assert.ErrorIs(t, err, ErrValidation)
+}
@@ -0,0 +1,83 @@
+package hyperion
+
+import (
+	"context"
+
+	m "github.com/denwwer/hyperion-ng/internal/model"
+)
+
+const cmdSystem = "system"
+
+const (
+	systemSuspend       = "suspend"
+	systemResume        = "resume"
+	systemToggleSuspend = "toggleSuspend"
+	systemIdle          = "idle"
+	systemToggleIdle    = "toggleIdle"
+	systemRestart       = "restart"
+)
+
+// Suspend all instances and turn LEDs off.
+func (c Client) Suspend() error {
+	return c.SuspendContext(context.Background())
+}
+
+// SuspendContext same as Suspend with context.
+func (c Client) SuspendContext(ctx context.Context) error {
+	return c.system(ctx, systemSuspend)
+}
+
+// Resume instances from suspend or idle.
+func (c Client) Resume() error {
+	return c.ResumeContext(context.Background())
+}
+
+// ResumeContext same as Resume with context.
+func (c Client) ResumeContext(ctx context.Context) error {
+	return c.system(ctx, systemResume)
+}
+
+// ToggleSuspend between suspended and resumed.
+func (c Client) ToggleSuspend() error {
+	return c.ToggleSuspendContext(context.Background())
+}
+
+// ToggleSuspendContext same as ToggleSuspend with context.
+func (c Client) ToggleSuspendContext(ctx context.Context) error {
+	return c.system(ctx, systemToggleSuspend)
+}
+
+// Idle keeps instances running but shows only background effect or color.
+func (c Client) Idle() error {
+	return c.IdleContext(context.Background())
+}
+
+// IdleContext same as Idle with context.
+func (c Client) IdleContext(ctx context.Context) error {
+	return c.system(ctx, systemIdle)
+}
+
+// ToggleIdle between idle and resumed.
+func (c Client) ToggleIdle() error {
+	return c.ToggleIdleContext(context.Background())
+}
+
+// ToggleIdleContext same as ToggleIdle with context.
+func (c Client) ToggleIdleContext(ctx context.Context) error {
+	return c.system(ctx, systemToggleIdle)
+}
+
+// Restart Hyperion.
+func (c Client) Restart() error {
+	return c.RestartContext(context.Background())
+}
+
+// RestartContext same as Restart with context.
+func (c Client) RestartContext(ctx context.Context) error {
+	return c.system(ctx, systemRestart)
+}
+
+func (c Client) system(ctx context.Context, subcommand string) error {
+	req := m.Request{Command: cmdSystem, Subcommand: subcommand}
+	return c.send(ctx, req, nil)
+}
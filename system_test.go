@@ -0,0 +1,30 @@
+package hyperion
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystem(t *testing.T) {
+	t.Parallel()
+
+	c, requests := testMemClient(nil)
+
+	require.Nil(t, c.Resume())
+	require.Nil(t, c.Suspend())
+	require.Nil(t, c.ToggleSuspend())
+	require.Nil(t, c.Idle())
+	require.Nil(t, c.ToggleIdle())
+	require.Nil(t, c.Restart())
+
+	var subcommands []interface{}
+	for _, req := range requests.all() {
+		if req["command"] == "system" {
+			subcommands = append(subcommands, req["subcommand"])
+		}
+	}
+
+	assert.Equal(t, []interface{}{"resume", "suspend", "toggleSuspend", "idle", "toggleIdle", "restart"}, subcommands)
+}
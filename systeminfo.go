@@ -1,6 +1,8 @@
 package hyperion
 
 import (
+	"context"
+
 	m "github.com/denwwer/hyperion-ng/internal/model"
 	"github.com/denwwer/hyperion-ng/model"
 )
@@ -9,8 +11,12 @@ const cmdSysInfo = "sysinfo"
 
 // SystemInfo retrieve basic system information about Hyperion server.
 func (c *Client) SystemInfo() (*model.System, error) {
-	tan := 1
-	req := m.Request{Command: cmdSysInfo, Tan: &tan}
+	return c.SystemInfoContext(context.Background())
+}
+
+// SystemInfoContext same as SystemInfo with context.
+func (c *Client) SystemInfoContext(ctx context.Context) (*model.System, error) {
+	req := m.Request{Command: cmdSysInfo}
 	resp := &model.System{}
-	return resp, c.send(req, &resp)
+	return resp, c.send(ctx, req, &resp)
 }
@@ -0,0 +1,37 @@
+package hyperion
+
+import (
+	"bufio"
+	"net"
+	"time"
+)
+
+// defaultTCPPort of Hyperion JSON server.
+const defaultTCPPort = 19444
+
+// tcpConn to Hyperion JSON server,
+// each request and response is a single JSON object terminated by new line.
+type tcpConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func dialTCP(addr string) func(timeout time.Duration) (frameConn, error) {
+	return func(timeout time.Duration) (frameConn, error) {
+		conn, err := net.DialTimeout("tcp", addr, timeout)
+		if err != nil {
+			return nil, err
+		}
+
+		return &tcpConn{Conn: conn, reader: bufio.NewReader(conn)}, nil
+	}
+}
+
+func (t *tcpConn) writeMessage(msg []byte) error {
+	_, err := t.Write(append(msg, '\n'))
+	return err
+}
+
+func (t *tcpConn) readMessage() ([]byte, error) {
+	return t.reader.ReadBytes('\n')
+}
@@ -0,0 +1,46 @@
+package hyperion
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTCP(t *testing.T) {
+	t.Parallel()
+	c := testTCPClient()
+	defer c.Close()
+
+	info, err := c.ServerInfo()
+	require.Nil(t, err)
+	assert.Equal(t, "First LED Hardware instance", info.Instances[0].Name)
+
+	err = c.SetColor([]int{0, 0, 0}, 20, "test 1", nil)
+	require.Nil(t, err)
+
+	err = c.SetSource(-1)
+	assert.EqualError(t, err, "Errors during specific message validation, please consult the Hyperion Log")
+}
+
+func TestTCPReconnect(t *testing.T) {
+	t.Parallel()
+	c := testTCPClient()
+	defer c.Close()
+
+	_, err := c.SystemInfo()
+	require.Nil(t, err)
+
+	// drop connection, client should restore it on next request
+	c.transport.(*socketTransport).conn.frame.Close()
+
+	_, err = c.SystemInfo()
+	require.Nil(t, err)
+}
+
+func TestTCPAddress(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "localhost:19444", getURL(Config{Connection: Connection{Type: ConnectTCP, Host: "localhost"}}))
+	assert.Equal(t, "localhost:1234", getURL(Config{Connection: Connection{Type: ConnectTCP, Host: "localhost", Port: 1234}}))
+}
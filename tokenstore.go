@@ -0,0 +1,61 @@
+package hyperion
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// TokenStore persists API token between runs.
+type TokenStore interface {
+	Load() (string, error) // Returns empty token without error if nothing is stored
+	Save(token string) error
+}
+
+// FileTokenStore keeps token in file by path, file is created with owner only permissions.
+type FileTokenStore string
+
+// Load token from file.
+func (s FileTokenStore) Load() (string, error) {
+	b, err := os.ReadFile(string(s))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return strings.TrimSpace(string(b)), nil
+}
+
+// Save token to file.
+func (s FileTokenStore) Save(token string) error {
+	if err := os.MkdirAll(filepath.Dir(string(s)), 0o700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(string(s), []byte(token+"\n"), 0o600)
+}
+
+// EnvTokenStore keeps token in environment variable by name,
+// saved token is visible only for current process and its children.
+type EnvTokenStore string
+
+// Load token from environment.
+func (s EnvTokenStore) Load() (string, error) {
+	return os.Getenv(string(s)), nil
+}
+
+// Save token to environment.
+func (s EnvTokenStore) Save(token string) error {
+	return os.Setenv(string(s), token)
+}
+
+// WithTokenStore set token store, stored token is used when Config.Connection.Token is empty
+// and token received by RequestToken is saved to it.
+func WithTokenStore(store TokenStore) ClientOption {
+	return func(c *Client) {
+		c.tokenStore = store
+	}
+}
@@ -0,0 +1,32 @@
+package hyperion
+
+import "context"
+
+// Transport delivers encoded JSON request to Hyperion and returns raw JSON response.
+// Request encoding, retries and error mapping are done by Client,
+// so every Transport behaves the same way.
+type Transport interface {
+	RoundTrip(ctx context.Context, req []byte) ([]byte, error)
+	Close() error
+}
+
+// TransportFunc is an adapter to use ordinary function as Transport,
+// useful for in-memory transports in tests.
+type TransportFunc func(ctx context.Context, req []byte) ([]byte, error)
+
+// RoundTrip calls f(ctx, req).
+func (f TransportFunc) RoundTrip(ctx context.Context, req []byte) ([]byte, error) {
+	return f(ctx, req)
+}
+
+// Close does nothing.
+func (f TransportFunc) Close() error {
+	return nil
+}
+
+// WithTransport set custom transport, Config.Connection type and address are ignored.
+func WithTransport(t Transport) ClientOption {
+	return func(c *Client) {
+		c.transport = t
+	}
+}
@@ -0,0 +1,69 @@
+package hyperion
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransport(t *testing.T) {
+	t.Parallel()
+
+	c, requests := testMemClient(nil)
+	defer c.Close()
+
+	info, err := c.ServerInfo()
+	require.Nil(t, err)
+	assert.NotEmpty(t, info.Effects)
+
+	require.Nil(t, c.SetColor([]int{255, 0, 0}, 20, "test 1", nil))
+
+	err = c.SetSource(-1)
+	assert.EqualError(t, err, "Errors during specific message validation, please consult the Hyperion Log")
+
+	var commands []interface{}
+	for _, req := range requests.all() {
+		commands = append(commands, req["command"])
+	}
+
+	assert.Equal(t, []interface{}{"serverinfo", "color", "sourceselect"}, commands)
+}
+
+func TestSendContext(t *testing.T) {
+	t.Parallel()
+
+	attempts := 0
+	c := NewClient(Config{}, WithTransport(TransportFunc(func(ctx context.Context, req []byte) ([]byte, error) {
+		attempts++
+		return nil, errors.New("connection refused")
+	})))
+
+	// retry delay is interrupted by deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := c.SetColorContext(ctx, []int{255, 0, 0}, 20, "test 1", nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestSendContextHTTP(t *testing.T) {
+	t.Parallel()
+	c := testClient()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := c.ServerInfoContext(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	info, err := c.ServerInfoContext(context.Background())
+	require.Nil(t, err)
+	assert.NotEmpty(t, info.Components)
+}
@@ -0,0 +1,127 @@
+package hyperion
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sync"
+
+	m "github.com/denwwer/hyperion-ng/internal/model"
+	"github.com/denwwer/hyperion-ng/model"
+)
+
+// defaultInstance is selected by Hyperion for new session.
+const defaultInstance = 0
+
+// unboundCommands are not bound to instance of view.
+var unboundCommands = []string{cmdInstance, cmdLogging}
+
+// WithInstanceSwitch bind requests of ForInstance views by switching instance before every command,
+// it is required by Hyperion before 2.1 which ignores instance of request.
+// Switch and command are sent as one sequence, so views could be used concurrently,
+// commands of client itself switch back to default instance 0 after view.
+// Connection should be TCP or WebSocket to keep switched instance between requests,
+// events and streams follow switched instance since old Hyperion binds them to session.
+func WithInstanceSwitch() ClientOption {
+	return func(c *Client) {
+		c.instanceSwitch = true
+	}
+}
+
+// ForInstance returns view of client which commands are bound to given instances,
+// several instances are supported by newer Hyperion for some commands.
+// View shares connection, token and subscriptions with client.
+func (c *Client) ForInstance(instance int, more ...int) *Client {
+	view := *c
+	view.instances = append([]int{instance}, more...)
+	return &view
+}
+
+// switchedState keeps switch and command together and tracks that view left default instance.
+type switchedState struct {
+	mu   sync.Mutex
+	away bool
+}
+
+// bind adds instances of view to request the same way as it is sent,
+// so request kept for replay is restored on the same instances.
+func (c *Client) bind(command string, req []byte) []byte {
+	if c.instances == nil || c.instanceSwitch || slices.Contains(unboundCommands, command) {
+		return req
+	}
+
+	if bound, err := setInstance(req, c.instances); err == nil {
+		return bound
+	}
+
+	return req
+}
+
+// roundTrip sends request to instances of view.
+func (c *Client) roundTrip(ctx context.Context, command string, req []byte) ([]byte, error) {
+	if !c.instanceSwitch || slices.Contains(unboundCommands, command) {
+		return c.transport.RoundTrip(ctx, c.bind(command, req))
+	}
+
+	c.switched.mu.Lock()
+	defer c.switched.mu.Unlock()
+
+	instances := c.instances
+	if instances == nil {
+		if !c.switched.away {
+			return c.transport.RoundTrip(ctx, req)
+		}
+
+		instances = []int{defaultInstance}
+	}
+
+	var resp []byte
+	for _, instance := range instances {
+		switchReq, _ := json.Marshal(struct {
+			m.Request
+			Instance int `json:"instance"`
+		}{
+			Request:  m.Request{Command: cmdInstance, Subcommand: string(model.InstanceCmdSwitch)},
+			Instance: instance,
+		})
+
+		for i, r := range [][]byte{switchReq, req} {
+			var err error
+			if resp, err = c.transport.RoundTrip(ctx, r); err != nil {
+				return nil, err
+			}
+
+			// failed response is returned to caller as is
+			respData := m.Response{}
+			if json.Unmarshal(resp, &respData) != nil || !respData.Success {
+				return resp, nil
+			}
+
+			if i == 0 {
+				c.switched.away = instance != defaultInstance
+			}
+		}
+	}
+
+	return resp, nil
+}
+
+// setInstance adds instance to encoded request unless it is set by command.
+func setInstance(req []byte, instances []int) ([]byte, error) {
+	data := map[string]json.RawMessage{}
+	if err := json.Unmarshal(req, &data); err != nil {
+		return nil, err
+	}
+
+	if _, ok := data["instance"]; ok {
+		return req, nil
+	}
+
+	if len(instances) == 1 {
+		data["instance"], _ = json.Marshal(instances[0])
+	} else {
+		data["instance"], _ = json.Marshal(instances)
+	}
+
+	return json.Marshal(data)
+}
@@ -0,0 +1,106 @@
+package hyperion
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/denwwer/hyperion-ng/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForInstance(t *testing.T) {
+	t.Parallel()
+
+	c, recorded := testMemClient(nil)
+
+	require.Nil(t, c.ForInstance(1).SetColor([]int{255, 0, 0}, 20, "test 1", nil))
+	require.Nil(t, c.ForInstance(0, 1).ComponentState("LEDDEVICE", false))
+	require.Nil(t, c.ForInstance(1).Instance(2, "startInstance"))
+	require.Nil(t, c.SetColor([]int{255, 0, 0}, 20, "test 1", nil))
+
+	requests := recorded.all()
+	require.Len(t, requests, 4)
+	assert.Equal(t, float64(1), requests[0]["instance"])
+	assert.Equal(t, []interface{}{float64(0), float64(1)}, requests[1]["instance"])
+	assert.Equal(t, float64(2), requests[2]["instance"]) // set by command
+	assert.NotContains(t, requests[3], "instance")
+}
+
+func TestForInstanceReplay(t *testing.T) {
+	t.Parallel()
+	c := testTCPClient()
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := c.ForInstance(1).Events(ctx, model.EventVideoMode)
+	require.Nil(t, err)
+
+	s := c.transport.(*socketTransport)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// subscription is restored on instance of view
+	require.Len(t, s.replay, 1)
+	req := map[string]interface{}{}
+	require.Nil(t, json.Unmarshal(s.replay[0], &req))
+	assert.Equal(t, float64(1), req["instance"])
+}
+
+func TestInstanceSwitch(t *testing.T) {
+	t.Parallel()
+
+	current := 0
+	executed := map[int][]float64{} // instance: red of colors
+
+	c, _ := testMemClient(func(data map[string]interface{}) []byte {
+		switch data["command"] {
+		case "instance":
+			current = int(data["instance"].(float64))
+			if current > 2 {
+				return []byte(`{"command":"instance","success":false,"error":"Instance is not running"}`)
+			}
+		case "color":
+			executed[current] = append(executed[current], data["color"].([]interface{})[0].(float64))
+		}
+
+		return nil
+	}, WithInstanceSwitch())
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 20; i++ {
+		for _, instance := range []int{1, 2} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.Nil(t, c.ForInstance(instance).SetColor([]int{instance, 0, 0}, 20, "test 1", nil))
+			}()
+		}
+	}
+	wg.Wait()
+
+	// command of client switches back to default instance
+	require.Nil(t, c.SetColor([]int{0, 0, 0}, 20, "test 1", nil))
+
+	// every command is executed on instance of its view
+	assert.Len(t, executed[0], 1)
+	assert.Len(t, executed[1], 20)
+	assert.Len(t, executed[2], 20)
+	for instance, colors := range executed {
+		for _, red := range colors {
+			assert.Equal(t, float64(instance), red)
+		}
+	}
+
+	var apiErr *APIError
+	err := c.ForInstance(5).SetColor([]int{5, 0, 0}, 20, "test 1", nil)
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "instance", apiErr.Command)
+	assert.Equal(t, "Instance is not running", apiErr.Message)
+}
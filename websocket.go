@@ -0,0 +1,47 @@
+package hyperion
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// wsConn to Hyperion WebSocket endpoint, JSON messages are sent as text frames.
+type wsConn struct {
+	*websocket.Conn
+}
+
+func dialWebSocket(url string, header http.Header) func(timeout time.Duration) (frameConn, error) {
+	return func(timeout time.Duration) (frameConn, error) {
+		dialer := websocket.Dialer{
+			Proxy:            http.ProxyFromEnvironment,
+			HandshakeTimeout: timeout,
+		}
+
+		conn, resp, err := dialer.Dial(url, header)
+		if err != nil {
+			return nil, err
+		}
+		resp.Body.Close()
+
+		return &wsConn{Conn: conn}, nil
+	}
+}
+
+func (w *wsConn) writeMessage(msg []byte) error {
+	return w.WriteMessage(websocket.TextMessage, msg)
+}
+
+func (w *wsConn) readMessage() ([]byte, error) {
+	for {
+		typ, msg, err := w.ReadMessage()
+		if err != nil {
+			return nil, err
+		}
+
+		if typ == websocket.TextMessage {
+			return msg, nil
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package hyperion
+
+import (
+	"testing"
+
+	"github.com/denwwer/hyperion-ng/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebSocket(t *testing.T) {
+	t.Parallel()
+	c := testWSClient()
+	defer c.Close()
+
+	info, err := c.ServerInfo()
+	require.Nil(t, err)
+	assert.NotEmpty(t, info.Components)
+
+	sys, err := c.SystemInfo()
+	require.Nil(t, err)
+	assert.Equal(t, "linux", sys.System.KernelType)
+
+	require.Nil(t, c.SetColor([]int{0, 0, 0}, 20, "test 1", nil))
+	require.Nil(t, c.SetEffect(model.Effect{Name: "Blue mood blobs"}, 20, "test 1", nil))
+	require.Nil(t, c.SetImage(model.Image{ImageB64: imageB64, Name: "New image"}, 20, "test 1", nil))
+	require.Nil(t, c.ClearPriority(20))
+	require.Nil(t, c.SetSourceAuto())
+	require.Nil(t, c.SetAdjustment(model.Adjustment{Green: []int{0, 236, 0}}))
+	require.Nil(t, c.LEDMode(model.LEDModeAdvanced))
+	require.Nil(t, c.VideoMode(model.VideoMode2D))
+	require.Nil(t, c.ComponentState("LEDDEVICE", true))
+	require.Nil(t, c.Instance(0, model.InstanceCmdSwitch))
+
+	err = c.SetSource(-1)
+	assert.EqualError(t, err, "Errors during specific message validation, please consult the Hyperion Log")
+}
+
+func TestWebSocketAddress(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "ws://localhost:8090/", getURL(Config{Connection: Connection{Type: ConnectWebSocket, Host: "localhost", Port: 8090}}))
+	assert.Equal(t, "wss://localhost/", getURL(Config{Connection: Connection{Type: ConnectWebSocket, Host: "localhost", SSL: true}}))
+}
@@ -1,7 +1,7 @@
 package hyperion
 
 import (
-	"errors"
+	"context"
 
 	m "github.com/denwwer/hyperion-ng/internal/model"
 	"github.com/denwwer/hyperion-ng/model"
@@ -22,9 +22,24 @@ const (
 
 // SetColor for all LEDs.
 func (c Client) SetColor(color []int, priority int, origin string, duration *int) error {
+	return c.SetColorContext(context.Background(), color, priority, origin, duration)
+}
+
+// SetColorContext same as SetColor with context.
+func (c Client) SetColorContext(ctx context.Context, color []int, priority int, origin string, duration *int) error {
 	// [R, G, B] or [R, G, B, R, G, B ...]
-	if len(color) < 2 {
-		return errors.New(m.ColorRequired)
+	if len(color) < 3 {
+		return ErrColorRequired
+	}
+
+	if len(color)%3 != 0 {
+		return ErrColorInvalid
+	}
+
+	for _, v := range color {
+		if v < 0 || v > 255 {
+			return ErrColorInvalid
+		}
 	}
 
 	if err := validate(priority, origin, duration); err != nil {
@@ -44,15 +59,40 @@ func (c Client) SetColor(color []int, priority int, origin string, duration *int
 		Color: color,
 	}
 
-	return c.send(req, nil)
+	return c.send(ctx, req, nil)
+}
+
+// SetColors pattern repeated over LEDs, single color is set for all LEDs.
+func (c Client) SetColors(colors []model.Color, priority int, origin string, duration *int) error {
+	return c.SetColorsContext(context.Background(), colors, priority, origin, duration)
+}
+
+// SetColorsContext same as SetColors with context.
+func (c Client) SetColorsContext(ctx context.Context, colors []model.Color, priority int, origin string, duration *int) error {
+	if len(colors) == 0 {
+		return ErrColorRequired
+	}
+
+	return c.SetColorContext(ctx, model.Colors(colors).Ints(), priority, origin, duration)
 }
 
 // SetEffect by name with optional overridden arguments.
 func (c Client) SetEffect(effect model.Effect, priority int, origin string, duration *int) error {
+	return c.SetEffectContext(context.Background(), effect, priority, origin, duration)
+}
+
+// SetEffectContext same as SetEffect with context.
+func (c Client) SetEffectContext(ctx context.Context, effect model.Effect, priority int, origin string, duration *int) error {
 	if err := validate(priority, origin, duration); err != nil {
 		return err
 	}
 
+	if c.effectValidation {
+		if err := c.ValidateEffectContext(ctx, effect); err != nil {
+			return err
+		}
+	}
+
 	req := struct {
 		m.Request
 		Effect model.Effect `json:"effect"`
@@ -66,11 +106,16 @@ func (c Client) SetEffect(effect model.Effect, priority int, origin string, dura
 		Effect: effect,
 	}
 
-	return c.send(req, nil)
+	return c.send(ctx, req, nil)
 }
 
 // SetImage a single image.
 func (c Client) SetImage(image model.Image, priority int, origin string, duration *int) error {
+	return c.SetImageContext(context.Background(), image, priority, origin, duration)
+}
+
+// SetImageContext same as SetImage with context.
+func (c Client) SetImageContext(ctx context.Context, image model.Image, priority int, origin string, duration *int) error {
 	if err := validate(priority, origin, duration); err != nil {
 		return err
 	}
@@ -93,11 +138,16 @@ func (c Client) SetImage(image model.Image, priority int, origin string, duratio
 		req.Format = &f
 	}
 
-	return c.send(req, nil)
+	return c.send(ctx, req, nil)
 }
 
 // ClearPriority used to revert SetColor, SetEffect or SetImage.
 func (c Client) ClearPriority(priority int) error {
+	return c.ClearPriorityContext(context.Background(), priority)
+}
+
+// ClearPriorityContext same as ClearPriority with context.
+func (c Client) ClearPriorityContext(ctx context.Context, priority int) error {
 	req := struct {
 		m.Request
 	}{
@@ -107,11 +157,16 @@ func (c Client) ClearPriority(priority int) error {
 		},
 	}
 
-	return c.send(req, nil)
+	return c.send(ctx, req, nil)
 }
 
 // SetSource priority manually.
 func (c Client) SetSource(priority int) error {
+	return c.SetSourceContext(context.Background(), priority)
+}
+
+// SetSourceContext same as SetSource with context.
+func (c Client) SetSourceContext(ctx context.Context, priority int) error {
 	req := struct {
 		m.Request
 	}{
@@ -121,11 +176,16 @@ func (c Client) SetSource(priority int) error {
 		},
 	}
 
-	return c.send(req, nil)
+	return c.send(ctx, req, nil)
 }
 
 // SetSourceAuto visible source is determined by priority.
 func (c Client) SetSourceAuto() error {
+	return c.SetSourceAutoContext(context.Background())
+}
+
+// SetSourceAutoContext same as SetSourceAuto with context.
+func (c Client) SetSourceAutoContext(ctx context.Context) error {
 	req := struct {
 		m.Request
 		Auto bool `json:"auto"`
@@ -134,11 +194,16 @@ func (c Client) SetSourceAuto() error {
 		Auto:    true,
 	}
 
-	return c.send(req, nil)
+	return c.send(ctx, req, nil)
 }
 
 // SetAdjustment to color calibration.
 func (c Client) SetAdjustment(adj model.Adjustment) error {
+	return c.SetAdjustmentContext(context.Background(), adj)
+}
+
+// SetAdjustmentContext same as SetAdjustment with context.
+func (c Client) SetAdjustmentContext(ctx context.Context, adj model.Adjustment) error {
 	req := struct {
 		m.Request
 		Adjustment model.Adjustment `json:"adjustment"`
@@ -147,11 +212,16 @@ func (c Client) SetAdjustment(adj model.Adjustment) error {
 		Adjustment: adj,
 	}
 
-	return c.send(req, nil)
+	return c.send(ctx, req, nil)
 }
 
 // LEDMode switched the LED mapping mode for the incoming image.
 func (c Client) LEDMode(mode model.LEDMode) error {
+	return c.LEDModeContext(context.Background(), mode)
+}
+
+// LEDModeContext same as LEDMode with context.
+func (c Client) LEDModeContext(ctx context.Context, mode model.LEDMode) error {
 	req := struct {
 		m.Request
 		Type model.LEDMode `json:"mappingType"`
@@ -160,11 +230,16 @@ func (c Client) LEDMode(mode model.LEDMode) error {
 		Type:    mode,
 	}
 
-	return c.send(req, nil)
+	return c.send(ctx, req, nil)
 }
 
 // VideoMode switching.
 func (c Client) VideoMode(mode model.VideoMode) error {
+	return c.VideoModeContext(context.Background(), mode)
+}
+
+// VideoModeContext same as VideoMode with context.
+func (c Client) VideoModeContext(ctx context.Context, mode model.VideoMode) error {
 	req := struct {
 		m.Request
 		Mode model.VideoMode `json:"videoMode"`
@@ -173,11 +248,16 @@ func (c Client) VideoMode(mode model.VideoMode) error {
 		Mode:    mode,
 	}
 
-	return c.send(req, nil)
+	return c.send(ctx, req, nil)
 }
 
 // ComponentState enabled or disabled at runtime.
 func (c Client) ComponentState(name string, enable bool) error {
+	return c.ComponentStateContext(context.Background(), name, enable)
+}
+
+// ComponentStateContext same as ComponentState with context.
+func (c Client) ComponentStateContext(ctx context.Context, name string, enable bool) error {
 	req := struct {
 		m.Request
 		Component map[string]interface{} `json:"componentstate"`
@@ -188,11 +268,16 @@ func (c Client) ComponentState(name string, enable bool) error {
 		Component: map[string]interface{}{"component": name, "state": enable},
 	}
 
-	return c.send(req, nil)
+	return c.send(ctx, req, nil)
 }
 
 // Instance controlling.
 func (c Client) Instance(instance int, command model.InstanceCmd) error {
+	return c.InstanceContext(context.Background(), instance, command)
+}
+
+// InstanceContext same as Instance with context.
+func (c Client) InstanceContext(ctx context.Context, instance int, command model.InstanceCmd) error {
 	req := struct {
 		m.Request
 		Instance int `json:"instance"`
@@ -204,20 +289,20 @@ func (c Client) Instance(instance int, command model.InstanceCmd) error {
 		Instance: instance,
 	}
 
-	return c.send(req, nil)
+	return c.send(ctx, req, nil)
 }
 
 func validate(priority int, origin string, duration *int) error {
 	if priority < 1 {
-		return errors.New(m.PriorityRequired)
+		return ErrPriorityRequired
 	}
 
 	if len(origin) < 3 {
-		return errors.New(m.OriginRequired)
+		return ErrOriginRequired
 	}
 
 	if duration != nil && *duration < 0 {
-		return errors.New(m.DurationRequired)
+		return ErrDurationRequired
 	}
 
 	return nil